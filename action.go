@@ -2,21 +2,37 @@ package pokerlib
 
 func (g *game) ReadyForAll() error {
 
+	if g.IsHandComplete() {
+		return ErrHandComplete
+	}
+
 	if g.gs.Status.CurrentEvent != "ReadyRequested" {
 		return ErrInvalidAction
 	}
 
 	g.ResetAllPlayerAllowedActions()
 
+	g.appendActionHistory(-1, "ready", 0)
+
 	return g.EmitEvent(GameEvent_Readiness)
 }
 
 func (g *game) Pass() error {
-	return g.GetCurrentPlayer().Pass()
+
+	p, err := g.actor()
+	if err != nil {
+		return err
+	}
+
+	return p.Pass()
 }
 
 func (g *game) PayAnte() error {
 
+	if g.IsHandComplete() {
+		return ErrHandComplete
+	}
+
 	if g.gs.Meta.Ante == 0 {
 		return ErrInvalidAction
 	}
@@ -26,6 +42,10 @@ func (g *game) PayAnte() error {
 	}
 
 	for _, p := range g.GetPlayers() {
+		if p.State().isDealtOut() {
+			continue
+		}
+
 		err := p.PayAnte()
 		if err != nil {
 			return err
@@ -37,13 +57,44 @@ func (g *game) PayAnte() error {
 	return g.EmitEvent(GameEvent_AntePaid)
 }
 
+// keepLiveAnte leaves antes in wagers of players so that they count toward wager of preflop.
+func (g *game) keepLiveAnte() error {
+
+	err := g.ResetRoundStatus()
+	if err != nil {
+		return err
+	}
+
+	for _, ps := range g.gs.Players {
+		g.gs.Status.CurrentRoundPot += ps.Wager
+
+		if ps.Wager > g.gs.Status.CurrentWager {
+			g.gs.Status.CurrentWager = ps.Wager
+		}
+	}
+
+	return nil
+}
+
 func (g *game) PayBlinds() error {
 
+	if g.IsHandComplete() {
+		return ErrHandComplete
+	}
+
 	if g.gs.Status.CurrentEvent != "BlindsRequested" {
 		return ErrInvalidAction
 	}
 
+	if g.forcedBet != nil {
+		return g.payForcedBet()
+	}
+
 	for _, p := range g.GetPlayers() {
+		if p.State().isDealtOut() {
+			continue
+		}
+
 		err := p.PayBlinds()
 		if err != nil {
 			return err
@@ -62,30 +113,238 @@ func (g *game) PayBlinds() error {
 	return g.EmitEvent(GameEvent_BlindsPaid)
 }
 
+func (g *game) payForcedBet() error {
+
+	seatIdx, amount := g.forcedBet(g.gs)
+
+	p := g.Player(seatIdx)
+	if p == nil {
+		return ErrInvalidAction
+	}
+
+	err := p.PayForcedBet(amount)
+	if err != nil {
+		return err
+	}
+
+	// Minimal raise size
+	g.gs.Status.PreviousRaiseSize = g.gs.Meta.Blind.BB
+	if g.gs.Status.PreviousRaiseSize == 0 {
+		g.gs.Status.PreviousRaiseSize = amount
+	}
+
+	g.ResetAllPlayerAllowedActions()
+
+	return g.EmitEvent(GameEvent_BlindsPaid)
+}
+
 func (g *game) Pay(chips int64) error {
-	return g.GetCurrentPlayer().Pay(chips)
+
+	p, err := g.actor()
+	if err != nil {
+		return err
+	}
+
+	return p.Pay(chips)
 }
 
 func (g *game) Fold() error {
-	return g.GetCurrentPlayer().Fold()
+
+	p, err := g.actor()
+	if err != nil {
+		return err
+	}
+
+	return p.Fold()
 }
 
 func (g *game) Check() error {
-	return g.GetCurrentPlayer().Check()
+
+	p, err := g.actor()
+	if err != nil {
+		return err
+	}
+
+	return p.Check()
 }
 
 func (g *game) Call() error {
-	return g.GetCurrentPlayer().Call()
+
+	p, err := g.actor()
+	if err != nil {
+		return err
+	}
+
+	return p.Call()
 }
 
 func (g *game) Allin() error {
-	return g.GetCurrentPlayer().Allin()
+
+	p, err := g.actor()
+	if err != nil {
+		return err
+	}
+
+	return p.Allin()
 }
 
 func (g *game) Bet(chips int64) error {
-	return g.GetCurrentPlayer().Bet(chips)
+
+	p, err := g.actor()
+	if err != nil {
+		return err
+	}
+
+	return p.Bet(chips)
 }
 
 func (g *game) Raise(chipLevel int64) error {
-	return g.GetCurrentPlayer().Raise(chipLevel)
+
+	p, err := g.actor()
+	if err != nil {
+		return err
+	}
+
+	return p.Raise(chipLevel)
+}
+
+func (g *game) Complete() error {
+
+	p, err := g.actor()
+	if err != nil {
+		return err
+	}
+
+	return p.Complete()
+}
+
+// RaiseTo raises the total wager of current player to the specific chips, which is the same as Raise
+// but the amount must satisfy the minimum raise unless player is going all-in.
+func (g *game) RaiseTo(total int64) error {
+
+	p, err := g.actor()
+	if err != nil {
+		return err
+	}
+
+	if total < g.MinRaise() {
+		return ErrIllegalRaise
+	}
+
+	return p.Raise(total)
+}
+
+// RaiseBy raises by the specific chips over the current wager on the table.
+func (g *game) RaiseBy(amount int64) error {
+	return g.RaiseTo(g.gs.Status.CurrentWager + amount)
+}
+
+// AutoAct applies the default action for the current player, which is checking if it is free, otherwise
+// folding. It is useful for servers to act for players whose clock has expired.
+func (g *game) AutoAct(idx int) error {
+
+	if g.IsHandComplete() {
+		return ErrHandComplete
+	}
+
+	p := g.GetCurrentPlayer()
+	if p == nil || p.SeatIndex() != idx {
+		return ErrNotCurrentPlayer
+	}
+
+	switch {
+	case p.CheckAction("check"):
+		return p.Check()
+	case p.CheckAction("fold"):
+		return p.Fold()
+	case p.CheckAction("pass"):
+		return p.Pass()
+	}
+
+	return ErrInvalidAction
+}
+
+// ForceFold folds the player for the current hand even if it is not the turn of player. Player is
+// flagged as sitting out, which should be carried to subsequent hands until player is reactivated.
+func (g *game) ForceFold(idx int) error {
+
+	p := g.Player(idx)
+	if p == nil {
+		return ErrNotFoundPlayer
+	}
+
+	ps := p.State()
+	ps.SitOut = true
+
+	if ps.Fold || g.gs.Status.CurrentEvent == "GameClosed" {
+		return nil
+	}
+
+	// The last player who didn't fold takes the pot, so that pot is never left without a winner
+	if g.GetAlivePlayerCount() == 1 {
+		return nil
+	}
+
+	return g.foldPlayer(p, "fold")
+}
+
+// MarkDeadHand kills the hand of player, e.g., for a misdeal of hole cards. Player is folded
+// immediately, so that chips which were committed stay in pot and player is not able to win it.
+func (g *game) MarkDeadHand(idx int) error {
+
+	if g.IsHandComplete() {
+		return ErrHandComplete
+	}
+
+	p := g.Player(idx)
+	if p == nil {
+		return ErrNotFoundPlayer
+	}
+
+	ps := p.State()
+	if ps.Fold {
+		ps.DeadHand = true
+		return nil
+	}
+
+	// Pot would be left without a winner
+	if g.GetAlivePlayerCount() == 1 {
+		return ErrLastAlivePlayer
+	}
+
+	ps.DeadHand = true
+
+	return g.foldPlayer(p, "dead_hand")
+}
+
+// foldPlayer folds the player who is not necessarily the current player, and moves on if needed.
+func (g *game) foldPlayer(p Player, action string) error {
+
+	idx := p.SeatIndex()
+	ps := p.State()
+	ps.Fold = true
+	ps.DidAction = "fold"
+	p.ResetAllowedActions()
+
+	g.UpdateLastAction(idx, action, 0)
+
+	// Moving to the next player. Player who folded out of turn still passes in turn, otherwise the
+	// round would be closed once it is the turn of player.
+	cp := g.GetCurrentPlayer()
+	if cp != nil && cp.SeatIndex() == idx {
+		ps.Acted = true
+		return g.Resume()
+	}
+
+	// Nobody is able to compete with the last player
+	if g.GetAlivePlayerCount() == 1 && g.gs.Status.CurrentEvent == "RoundStarted" {
+
+		if cp != nil {
+			cp.ResetAllowedActions()
+		}
+
+		return g.Resume()
+	}
+
+	return nil
 }
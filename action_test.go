@@ -0,0 +1,635 @@
+package pokerlib
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func startTestGame(t *testing.T, opts *GameOptions) *game {
+
+	g := NewGame(opts)
+	assert.Nil(t, g.Start())
+	assert.Nil(t, g.ReadyForAll())
+	assert.Nil(t, g.PayBlinds())
+	assert.Nil(t, g.ReadyForAll())
+	assert.Equal(t, "RoundStarted", g.GetEvent())
+
+	return g
+}
+
+func Test_Action_RaiseByAndRaiseTo(t *testing.T) {
+
+	g1 := startTestGame(t, newTestGameOptions(10000, 10000, 10000))
+	g2 := startTestGame(t, newTestGameOptions(10000, 10000, 10000))
+
+	// Dealer raises by 100 over big blind
+	assert.Nil(t, g1.RaiseBy(100))
+	assert.Nil(t, g2.RaiseTo(110))
+
+	for _, g := range []*game{g1, g2} {
+		assert.Equal(t, int64(110), g.GetState().Status.CurrentWager)
+		assert.Equal(t, int64(100), g.GetState().Status.PreviousRaiseSize)
+		assert.Equal(t, int64(110), g.Dealer().State().Wager)
+		assert.Equal(t, 0, g.GetState().Status.CurrentRaiser)
+		assert.Equal(t, 1, g.GetState().Status.CurrentPlayer)
+	}
+
+	// Minimum raise is required
+	assert.Equal(t, ErrIllegalRaise, g1.RaiseBy(50))
+	assert.Equal(t, ErrIllegalRaise, g2.RaiseTo(150))
+	assert.Nil(t, g1.RaiseBy(100))
+	assert.Nil(t, g2.RaiseTo(210))
+	assert.Equal(t, g1.SmallBlind().State().Wager, g2.SmallBlind().State().Wager)
+	assert.Equal(t, g1.GetState().Status.CurrentWager, g2.GetState().Status.CurrentWager)
+}
+
+func Test_Action_RaiseTo_Allin(t *testing.T) {
+
+	g := startTestGame(t, newTestGameOptions(10000, 10000, 15))
+
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Call())
+
+	// Big blind is allowed to go all-in even if it's less than minimum raise
+	assert.Nil(t, g.RaiseTo(15))
+	assert.Equal(t, "allin", g.BigBlind().State().DidAction)
+	assert.Equal(t, int64(0), g.BigBlind().State().StackSize)
+}
+
+func Test_Action_ForcedBet(t *testing.T) {
+
+	opts := newTestGameOptions(10000, 10000, 10000, 10000)
+	opts.Blind = BlindSetting{}
+	opts.ForcedBet = func(gs *GameState) (int, int64) {
+		return 2, 3
+	}
+
+	g := NewGame(opts)
+	assert.Nil(t, g.Start())
+	assert.Nil(t, g.ReadyForAll())
+	assert.Equal(t, "BlindsRequested", g.GetEvent())
+	assert.Nil(t, g.PayBlinds())
+
+	// Designated seat posted bring-in
+	assert.Equal(t, "bring_in", g.GetState().Status.LastAction.Type)
+	assert.Equal(t, 2, g.GetState().Status.LastAction.Source)
+	assert.Equal(t, int64(3), g.Player(2).State().Wager)
+	assert.Equal(t, int64(3), g.GetState().Status.CurrentRoundPot)
+	assert.Equal(t, int64(3), g.GetState().Status.CurrentWager)
+	for _, idx := range []int{0, 1, 3} {
+		assert.Equal(t, int64(0), g.Player(idx).State().Wager)
+	}
+
+	// The player next to bring-in acts first
+	assert.Nil(t, g.ReadyForAll())
+	assert.Equal(t, 3, g.GetCurrentPlayer().SeatIndex())
+
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Check())
+
+	// Bring-in is in the pot
+	assert.Equal(t, "flop", g.GetState().Status.Round)
+	assert.Equal(t, int64(12), g.GetState().Status.Pots[0].Total)
+}
+
+func Test_Action_Complete(t *testing.T) {
+
+	// Only small blind is posted which is less than a full bet
+	opts := newTestGameOptions(10000, 10000, 10000, 10000)
+	opts.ForcedBet = func(gs *GameState) (int, int64) {
+		return 1, 5
+	}
+
+	g := NewGame(opts)
+	assert.Nil(t, g.Start())
+	assert.Nil(t, g.ReadyForAll())
+	assert.Nil(t, g.PayBlinds())
+	assert.Nil(t, g.ReadyForAll())
+
+	assert.Equal(t, 2, g.GetCurrentPlayer().SeatIndex())
+	assert.Equal(t, []string{"allin", "fold", "call", "complete", "raise"}, g.GetCurrentPlayer().State().AllowedActions)
+
+	// Completing the small blind to a full bet
+	assert.Nil(t, g.Complete())
+	assert.Equal(t, "complete", g.GetState().Status.LastAction.Type)
+	assert.Equal(t, int64(10), g.GetState().Status.LastAction.Value)
+	assert.Equal(t, int64(10), g.GetState().Status.CurrentWager)
+	assert.Equal(t, 2, g.GetState().Status.CurrentRaiser)
+	assert.Equal(t, 0, g.GetState().Status.RaiseCount)
+
+	// The bet is complete already
+	assert.NotContains(t, g.GetCurrentPlayer().State().AllowedActions, "complete")
+	assert.Equal(t, ErrActionNotAllowed, g.Complete())
+
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Call())
+	assert.Equal(t, "flop", g.GetState().Status.Round)
+	assert.Equal(t, int64(40), g.GetState().Status.Pots[0].Total)
+}
+
+func Test_Action_Allin_FullRaise(t *testing.T) {
+
+	g := startTestGame(t, newTestGameOptions(1000, 1000, 1000))
+
+	// All-in for a full raise over the big blind
+	assert.Equal(t, 0, g.GetCurrentPlayer().SeatIndex())
+	assert.Nil(t, g.Allin())
+	assert.Equal(t, int64(1000), g.GetState().Status.CurrentWager)
+	assert.Equal(t, int64(990), g.GetState().Status.PreviousRaiseSize)
+	assert.Equal(t, 0, g.GetState().Status.CurrentRaiser)
+	assert.Equal(t, 1, g.GetState().Status.RaiseCount)
+
+	// Action is reopened for everyone else
+	assert.False(t, g.Player(1).State().Acted)
+	assert.False(t, g.Player(2).State().Acted)
+	assert.Equal(t, 1, g.GetCurrentPlayer().SeatIndex())
+}
+
+func Test_Action_Allin_ShortRaise(t *testing.T) {
+
+	g := startTestGame(t, newTestGameOptions(10000, 45, 10000))
+
+	assert.Nil(t, g.Raise(30))
+	assert.Equal(t, int64(20), g.GetState().Status.PreviousRaiseSize)
+
+	// All-in for less than a full raise
+	assert.Equal(t, 1, g.GetCurrentPlayer().SeatIndex())
+	assert.Nil(t, g.Allin())
+	assert.Equal(t, int64(45), g.GetState().Status.CurrentWager)
+	assert.Equal(t, int64(20), g.GetState().Status.PreviousRaiseSize)
+	assert.Equal(t, 0, g.GetState().Status.CurrentRaiser)
+	assert.Equal(t, 1, g.GetState().Status.RaiseCount)
+
+	// Big blind who has not acted yet is still able to raise
+	assert.Equal(t, 2, g.GetCurrentPlayer().SeatIndex())
+	assert.Contains(t, g.GetCurrentPlayer().State().AllowedActions, "raise")
+	assert.Nil(t, g.Call())
+
+	// Raiser only responds to the extra chips
+	assert.Equal(t, 0, g.GetCurrentPlayer().SeatIndex())
+	assert.Equal(t, []string{"fold", "call"}, g.GetCurrentPlayer().State().AllowedActions)
+	assert.ErrorIs(t, g.Raise(100), ErrActionNotAllowed)
+	assert.Nil(t, g.Call())
+	assert.Equal(t, "flop", g.GetState().Status.Round)
+}
+
+func Test_Action_MaxRaisesPerStreet(t *testing.T) {
+
+	opts := newTestGameOptions(10000, 10000, 10000)
+	opts.MaxRaisesPerStreet = 2
+
+	g := startTestGame(t, opts)
+
+	assert.Nil(t, g.Raise(30))
+	assert.Nil(t, g.Raise(60))
+	assert.Equal(t, 2, g.GetState().Status.RaiseCount)
+
+	// The third raise is disallowed
+	cp := g.GetCurrentPlayer()
+	assert.Equal(t, []string{"fold", "call"}, cp.State().AllowedActions)
+	assert.Equal(t, ErrActionNotAllowed, g.Raise(120))
+	assert.Equal(t, ErrActionNotAllowed, g.Allin())
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Call())
+
+	// Counter was reset for the next street
+	assert.Equal(t, "flop", g.GetState().Status.Round)
+	assert.Equal(t, 0, g.GetState().Status.RaiseCount)
+	assert.Nil(t, g.ReadyForAll())
+	assert.Nil(t, g.Bet(100))
+	assert.Nil(t, g.Raise(200))
+	assert.Nil(t, g.Raise(300))
+	assert.NotContains(t, g.GetCurrentPlayer().State().AllowedActions, "raise")
+}
+
+func Test_Action_MaxRaisesPerStreet_Allin(t *testing.T) {
+
+	opts := newTestGameOptions(10000, 10000, 50)
+	opts.MaxRaisesPerStreet = 1
+
+	g := startTestGame(t, opts)
+	assert.Nil(t, g.Raise(100))
+	assert.Nil(t, g.Call())
+
+	// All-in for less than the current wager is still allowed once raises were capped
+	assert.Equal(t, []string{"allin", "fold"}, g.GetCurrentPlayer().State().AllowedActions)
+	assert.Nil(t, g.Allin())
+	assert.Equal(t, int64(0), g.Player(2).State().StackSize)
+	assert.Equal(t, "flop", g.GetState().Status.Round)
+}
+
+func Test_Action_DisabledActions(t *testing.T) {
+
+	opts := newTestGameOptions(10000, 10000, 10000)
+	opts.DisabledActions = map[string][]string{
+		"river": {"allin"},
+	}
+
+	g := startTestGame(t, opts)
+	assert.Contains(t, g.GetCurrentPlayer().State().AllowedActions, "allin")
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Check())
+
+	for g.GetState().Status.Round != "river" {
+		assert.Nil(t, g.ReadyForAll())
+		assert.Contains(t, g.GetCurrentPlayer().State().AllowedActions, "allin")
+		assert.Nil(t, g.Check())
+		assert.Nil(t, g.Check())
+		assert.Nil(t, g.Check())
+	}
+
+	// All-in is never offered on the river
+	assert.Nil(t, g.ReadyForAll())
+	assert.NotContains(t, g.GetCurrentPlayer().State().AllowedActions, "allin")
+	assert.Equal(t, ErrActionNotAllowed, g.Allin())
+	assert.Nil(t, g.Bet(100))
+
+	for g.GetEvent() == "RoundStarted" {
+		cp := g.GetCurrentPlayer()
+		assert.NotContains(t, cp.State().AllowedActions, "allin")
+		assert.NotContains(t, g.GetAvailableActions(cp), "allin")
+		assert.Nil(t, g.Call())
+	}
+
+	assert.Equal(t, "GameClosed", g.GetEvent())
+}
+
+func Test_Action_MinChipUnit(t *testing.T) {
+
+	opts := newTestGameOptions(10000, 10000, 1005)
+	opts.MinChipUnit = 5
+
+	g := startTestGame(t, opts)
+
+	// Non-multiple raise is rejected
+	assert.Equal(t, ErrChipUnitViolation, g.Raise(37))
+	assert.Equal(t, ErrChipUnitViolation, g.RaiseBy(27))
+	assert.Nil(t, g.Raise(40))
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Call())
+
+	assert.Equal(t, "flop", g.GetState().Status.Round)
+	assert.Nil(t, g.ReadyForAll())
+
+	// Non-multiple bet is rejected
+	assert.Equal(t, ErrChipUnitViolation, g.Bet(101))
+	assert.Nil(t, g.Bet(100))
+
+	// Going all-in is exempted
+	assert.Nil(t, g.Raise(965))
+	assert.Equal(t, "allin", g.GetState().Status.LastAction.Type)
+}
+
+func Test_Action_SpreadLimit(t *testing.T) {
+
+	opts := newTestGameOptions(10000, 10000, 10000)
+	opts.Blind = BlindSetting{SB: 1, BB: 2}
+	opts.Limit = "spread-limit"
+	opts.LimitSetting = LimitSetting{Min: 2, Max: 10}
+
+	g := startTestGame(t, opts)
+
+	// Raise over the spread is rejected
+	assert.Equal(t, ErrSpreadViolation, g.Raise(13))
+	assert.Nil(t, g.Raise(12))
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Call())
+
+	assert.Equal(t, "flop", g.GetState().Status.Round)
+	assert.Nil(t, g.ReadyForAll())
+
+	// Bet out of the spread is rejected
+	assert.Equal(t, ErrSpreadViolation, g.Bet(11))
+	assert.Equal(t, ErrSpreadViolation, g.Bet(1))
+	assert.Nil(t, g.Bet(5))
+	assert.Equal(t, int64(5), g.GetState().Status.CurrentWager)
+}
+
+func Test_Action_CallAmount_ShortStack(t *testing.T) {
+
+	g := startTestGame(t, newTestGameOptions(10000, 10000, 300))
+
+	// Dealer raises
+	assert.Equal(t, int64(10), g.CallAmount(0))
+	assert.Nil(t, g.Raise(1000))
+
+	// Small blind calls
+	assert.Equal(t, int64(995), g.CallAmount(1))
+	assert.Nil(t, g.Call())
+
+	// Big blind is not able to cover the call
+	assert.Equal(t, int64(290), g.CallAmount(2))
+	assert.NotContains(t, g.GetCurrentPlayer().State().AllowedActions, "call")
+	assert.Nil(t, g.Call())
+
+	bb := g.GetState().GetPlayer(2)
+	assert.Equal(t, "allin", bb.DidAction)
+	assert.Equal(t, int64(0), bb.StackSize)
+	assert.Equal(t, int64(300), bb.Pot+bb.Wager)
+
+	// Nothing to call for player who is all-in
+	assert.Equal(t, int64(0), g.CallAmount(2))
+}
+
+func Test_Action_CallAmount_Unbet(t *testing.T) {
+
+	g := startTestGame(t, newTestGameOptions(10000, 10000, 10000))
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Check())
+	assert.Nil(t, g.ReadyForAll())
+
+	// Nothing to call on the flop until someone bets
+	assert.Equal(t, "flop", g.GetState().Status.Round)
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, int64(0), g.CallAmount(i))
+		assert.False(t, g.IsCallAllin(i))
+	}
+
+	// Call amount is the bet rather than big blind
+	assert.Nil(t, g.Bet(20))
+	assert.Equal(t, int64(20), g.CallAmount(2))
+	assert.Nil(t, g.Call())
+	assert.Equal(t, int64(30), g.Player(2).State().Pot+g.Player(2).State().Wager)
+}
+
+func Test_Action_MinBet(t *testing.T) {
+
+	g := startTestGame(t, newTestGameOptions(10000, 10000, 15))
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Check())
+	assert.Nil(t, g.ReadyForAll())
+
+	// Bet smaller than big blind is illegal
+	assert.Equal(t, int64(10), g.MinBet())
+	assert.Equal(t, ErrIllegalBet, g.Bet(5))
+	assert.Nil(t, g.Check())
+
+	// Minimum bet is capped at stack which is smaller than big blind
+	assert.Equal(t, int64(5), g.MinBet())
+	assert.Nil(t, g.Allin())
+}
+
+func Test_Action_IsCallAllin(t *testing.T) {
+
+	g := startTestGame(t, newTestGameOptions(10000, 10000, 300, 5000))
+
+	// Calling big blind is not all-in
+	assert.False(t, g.IsCallAllin(3))
+	assert.Nil(t, g.Raise(1000))
+
+	// Only big blind is not able to cover the call
+	assert.False(t, g.IsCallAllin(0))
+	assert.Nil(t, g.Raise(3000))
+	assert.False(t, g.IsCallAllin(1))
+	assert.Nil(t, g.Call())
+
+	assert.True(t, g.IsCallAllin(2))
+	assert.Contains(t, g.GetCurrentPlayer().State().AllowedActions, "allin")
+	assert.NotContains(t, g.GetCurrentPlayer().State().AllowedActions, "call")
+	assert.Nil(t, g.Allin())
+
+	// Nothing to call for player who is all-in or folded
+	assert.False(t, g.IsCallAllin(2))
+	assert.False(t, g.IsCallAllin(3))
+	assert.Nil(t, g.Fold())
+	assert.False(t, g.IsCallAllin(3))
+}
+
+func Test_Action_AutoAct(t *testing.T) {
+
+	g := startTestGame(t, newTestGameOptions(10000, 10000, 10000))
+
+	// Dealer raises
+	assert.Nil(t, g.Raise(30))
+
+	// Only current player can be acted automatically
+	assert.Equal(t, ErrNotCurrentPlayer, g.AutoAct(2))
+
+	// Small blind is facing a bet so it is folded
+	assert.Nil(t, g.AutoAct(1))
+	assert.True(t, g.GetState().GetPlayer(1).Fold)
+	assert.Equal(t, "fold", g.GetState().Status.LastAction.Type)
+
+	assert.Nil(t, g.Call())
+
+	// Checking is free for big blind on the flop
+	assert.Equal(t, "flop", g.GetState().Status.Round)
+	assert.Nil(t, g.ReadyForAll())
+	assert.Nil(t, g.Pass())
+	assert.Nil(t, g.AutoAct(2))
+	assert.False(t, g.GetState().GetPlayer(2).Fold)
+	assert.Equal(t, "check", g.GetState().Status.LastAction.Type)
+}
+
+func Test_Action_PreviewActions(t *testing.T) {
+
+	g := startTestGame(t, newTestGameOptions(10000, 10000, 10000, 10000))
+
+	// Small blind is going to face the raise of UTG after dealer acts
+	assert.Equal(t, 3, g.GetState().Status.CurrentPlayer)
+	assert.Nil(t, g.Raise(30))
+
+	assert.Equal(t, 0, g.GetState().Status.CurrentPlayer)
+	preview := g.PreviewActions(1)
+	assert.Equal(t, 0, g.GetState().Status.CurrentPlayer)
+	assert.Empty(t, g.Player(1).State().AllowedActions)
+
+	assert.Nil(t, g.Call())
+	assert.Equal(t, 1, g.GetState().Status.CurrentPlayer)
+	assert.Equal(t, g.Player(1).State().AllowedActions, preview)
+
+	// Folded player faces nothing
+	assert.Nil(t, g.Fold())
+	assert.Empty(t, g.PreviewActions(1))
+	assert.Empty(t, g.PreviewActions(9))
+}
+
+func Test_Action_FirstToActRule_Dealer(t *testing.T) {
+
+	opts := newTestGameOptions(10000, 10000, 10000, 10000)
+	opts.FirstToActRule = "dealer"
+
+	g := startTestGame(t, opts)
+
+	// Preflop is not affected, small blind folds
+	assert.Equal(t, 3, g.GetCurrentPlayer().SeatIndex())
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Fold())
+	assert.Nil(t, g.Check())
+
+	// Dealer acts first postflop, folded player is requested to pass as usual
+	assert.Equal(t, "flop", g.GetState().Status.Round)
+	assert.Nil(t, g.ReadyForAll())
+	assert.Equal(t, 0, g.GetCurrentPlayer().SeatIndex())
+	assert.Nil(t, g.Check())
+	assert.Equal(t, 1, g.GetCurrentPlayer().SeatIndex())
+	assert.Equal(t, []string{"pass"}, g.GetCurrentPlayer().State().AllowedActions)
+	assert.Nil(t, g.Pass())
+	assert.Nil(t, g.Check())
+	assert.Nil(t, g.Check())
+	assert.Equal(t, "turn", g.GetState().Status.Round)
+}
+
+func Test_Action_LiveAnte(t *testing.T) {
+
+	start := func(liveAnte bool) *game {
+
+		opts := newTestGameOptions(10000, 10000, 10000)
+		opts.Ante = 2
+		opts.LiveAnte = liveAnte
+
+		g := NewGame(opts)
+		assert.Nil(t, g.Start())
+		assert.Nil(t, g.ReadyForAll())
+		assert.Nil(t, g.PayAnte())
+		assert.Nil(t, g.PayBlinds())
+		assert.Nil(t, g.ReadyForAll())
+		assert.Equal(t, "RoundStarted", g.GetEvent())
+
+		return g
+	}
+
+	dead := start(false)
+	live := start(true)
+
+	// Blinds are the same, live ante is a part of them
+	for _, g := range []*game{dead, live} {
+		assert.Equal(t, int64(10), g.GetState().Status.CurrentWager)
+		assert.Equal(t, int64(5), g.Player(1).State().Wager)
+		assert.Equal(t, int64(10), g.Player(2).State().Wager)
+	}
+
+	assert.Equal(t, int64(10000-2-5), dead.Player(1).State().StackSize)
+	assert.Equal(t, int64(10000-5), live.Player(1).State().StackSize)
+
+	// Dealer calls the big blind
+	for _, g := range []*game{dead, live} {
+		assert.Equal(t, 0, g.GetCurrentPlayer().SeatIndex())
+		assert.Nil(t, g.Call())
+		assert.Equal(t, int64(10), g.Player(0).State().Wager)
+		assert.Nil(t, g.Call())
+		assert.Nil(t, g.Check())
+		assert.Equal(t, "flop", g.GetState().Status.Round)
+	}
+
+	assert.Equal(t, int64(10000-2-10), dead.Player(0).State().StackSize)
+	assert.Equal(t, int64(10000-10), live.Player(0).State().StackSize)
+
+	// Dead antes are added to pot on top of wagers
+	assert.Equal(t, int64(36), dead.GetState().Status.Pots[0].Total)
+	assert.Equal(t, int64(30), live.GetState().Status.Pots[0].Total)
+}
+
+func Test_Action_Errors(t *testing.T) {
+
+	g := startTestGame(t, newTestGameOptions(10000, 10000, 10000))
+
+	// Dealer faces big blind preflop
+	assert.Equal(t, ErrActionNotAllowed, g.Check())
+	assert.Equal(t, ErrActionNotAllowed, g.Bet(100))
+	assert.Equal(t, ErrActionNotAllowed, g.Pass())
+	assert.Equal(t, ErrActionNotAllowed, g.Pay(10))
+	assert.ErrorIs(t, g.Check(), ErrInvalidAction)
+	assert.Equal(t, ErrIllegalRaise, g.Raise(5))
+	assert.Equal(t, ErrIllegalRaise, g.RaiseTo(15))
+	assert.Equal(t, ErrInsufficientChips, g.Raise(10001))
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Call())
+	assert.Equal(t, ErrActionNotAllowed, g.Call())
+	assert.Nil(t, g.Check())
+
+	// Flop
+	assert.Nil(t, g.ReadyForAll())
+	assert.Equal(t, ErrActionNotAllowed, g.Call())
+	assert.Equal(t, ErrActionNotAllowed, g.Raise(100))
+	assert.Equal(t, ErrIllegalBet, g.Bet(0))
+	assert.Equal(t, ErrInsufficientChips, g.Bet(9991))
+	assert.Nil(t, g.Bet(100))
+	assert.Nil(t, g.Fold())
+	assert.Nil(t, g.Fold())
+
+	// No player is able to act after hand is complete
+	assert.Equal(t, "GameClosed", g.GetEvent())
+	for _, err := range []error{g.Fold(), g.Check(), g.Call(), g.Allin(), g.Bet(100), g.Raise(100), g.Pass(), g.Pay(10)} {
+		assert.Equal(t, ErrHandComplete, err)
+	}
+
+	// Current player is cleared
+	g = startTestGame(t, newTestGameOptions(10000, 10000, 10000))
+	assert.Nil(t, g.SetCurrentPlayer(nil))
+	for _, err := range []error{g.Fold(), g.Check(), g.Call(), g.Allin(), g.Bet(100), g.Raise(100), g.Pass(), g.Pay(10)} {
+		assert.Equal(t, ErrNotCurrentPlayer, err)
+	}
+}
+
+func Test_Action_MinBetAndMinRaise(t *testing.T) {
+
+	g := startTestGame(t, newTestGameOptions(10000, 10000, 150))
+
+	// Minimum raise is accepted exactly at the threshold of engine
+	assert.Equal(t, int64(20), g.MinRaise())
+	assert.Equal(t, ErrIllegalRaise, g.RaiseTo(g.MinRaise()-1))
+	assert.Nil(t, g.RaiseTo(g.MinRaise()))
+	assert.Equal(t, 0, g.GetState().Status.CurrentRaiser)
+
+	// Small blind is able to raise by 10 again
+	assert.Equal(t, int64(30), g.MinRaise())
+	assert.Nil(t, g.RaiseTo(100))
+
+	// Big blind is not able to cover the minimum raise
+	assert.Equal(t, int64(150), g.MinRaise())
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Call())
+
+	// Flop
+	assert.Nil(t, g.ReadyForAll())
+	assert.Equal(t, int64(10), g.MinBet())
+	assert.Nil(t, g.Bet(g.MinBet()))
+
+	// Nobody is able to act
+	assert.Nil(t, g.SetCurrentPlayer(nil))
+	assert.Equal(t, int64(0), g.MinBet())
+	assert.Equal(t, int64(0), g.MinRaise())
+}
+
+func Test_Action_HandActionCounts(t *testing.T) {
+
+	g := startTestGame(t, newTestGameOptions(10000, 10000, 10000))
+
+	// Preflop: blinds are not voluntary
+	assert.Equal(t, ActionCounts{}, g.HandActionCounts(2))
+	assert.Nil(t, g.Raise(30))
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Raise(100))
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Fold())
+
+	// Flop
+	assert.Nil(t, g.ReadyForAll())
+	assert.Nil(t, g.Pass())
+	assert.Nil(t, g.Bet(100))
+	assert.Nil(t, g.Raise(300))
+	assert.Nil(t, g.Pass())
+	assert.Nil(t, g.Call())
+
+	// Turn: all-in of dealer is counted as a bet
+	assert.Nil(t, g.ReadyForAll())
+	assert.Nil(t, g.Pass())
+	assert.Nil(t, g.Check())
+	assert.Nil(t, g.Allin())
+	assert.Nil(t, g.Pass())
+	assert.Nil(t, g.Fold())
+
+	assert.Equal(t, ActionCounts{Bets: 1, Raises: 2, Calls: 1}, g.HandActionCounts(0))
+	assert.Equal(t, ActionCounts{Calls: 1}, g.HandActionCounts(1))
+	assert.Equal(t, ActionCounts{Bets: 1, Raises: 1, Calls: 1}, g.HandActionCounts(2))
+	assert.Equal(t, ActionCounts{}, g.HandActionCounts(3))
+}
@@ -1,6 +1,8 @@
 package actor
 
 import (
+	"errors"
+	"sync"
 	"time"
 
 	"github.com/d-protocol/pokerlib"
@@ -8,19 +10,78 @@ import (
 	"github.com/d-protocol/pokertable"
 )
 
+var (
+	ErrStateQueueTimeout = errors.New("actor: timed out waiting for state queue")
+)
+
+const (
+	DefaultStateQueueSize    = 1024
+	DefaultStateQueueTimeout = 5 * time.Second
+)
+
 type NativeTableAdapter struct {
-	actor Actor
-	table table.Table
-	state *table.State
+	actor        Actor
+	table        table.Table
+	state        *table.State
+	queue        chan *table.State
+	queueTimeout time.Duration
+	startOnce    sync.Once
+	done         chan struct{}
+	mu           sync.RWMutex
 }
 
 func NewNativeTableAdapter(t table.Table) *NativeTableAdapter {
 
 	return &NativeTableAdapter{
-		table: t,
+		table:        t,
+		queue:        make(chan *table.State, DefaultStateQueueSize),
+		queueTimeout: DefaultStateQueueTimeout,
+		done:         make(chan struct{}),
 	}
 }
 
+// SetQueueTimeout sets how long EnqueueNativeState waits for room in the queue.
+func (nta *NativeTableAdapter) SetQueueTimeout(timeout time.Duration) {
+	nta.queueTimeout = timeout
+}
+
+func (nta *NativeTableAdapter) startWorker() {
+	go func() {
+		defer close(nta.done)
+
+		// States are applied one by one in the order of being enqueued
+		for s := range nta.queue {
+			nta.UpdateNativeState(s)
+		}
+	}()
+}
+
+// EnqueueNativeState queues the state to be applied by a single worker, so that states are delivered
+// in order. It blocks while the queue is full and returns ErrStateQueueTimeout if there is still no
+// room after the timeout.
+func (nta *NativeTableAdapter) EnqueueNativeState(s *table.State) error {
+
+	nta.startOnce.Do(nta.startWorker)
+
+	timer := time.NewTimer(nta.queueTimeout)
+	defer timer.Stop()
+
+	select {
+	case nta.queue <- s:
+		return nil
+	case <-timer.C:
+		return ErrStateQueueTimeout
+	}
+}
+
+// Close waits for queued states to be applied and stops the worker. States must not be enqueued
+// after closing.
+func (nta *NativeTableAdapter) Close() {
+	nta.startOnce.Do(nta.startWorker)
+	close(nta.queue)
+	<-nta.done
+}
+
 func (nta *NativeTableAdapter) SetActor(a Actor) {
 	nta.actor = a
 }
@@ -33,7 +94,10 @@ func (nta *NativeTableAdapter) UpdateNativeState(s *table.State) error {
 
 	//state := s.Clone()
 	state := s
+
+	nta.mu.Lock()
 	nta.state = state
+	nta.mu.Unlock()
 
 	// Convert native table state to standard format
 	t := pokertable.Table{
@@ -98,10 +162,18 @@ func (nta *NativeTableAdapter) UpdateNativeState(s *table.State) error {
 }
 
 func (nta *NativeTableAdapter) GetGameState() *pokerlib.GameState {
+
+	nta.mu.RLock()
+	defer nta.mu.RUnlock()
+
 	return nta.state.GameState
 }
 
 func (nta *NativeTableAdapter) GetGamePlayerIndex(playerID string) int {
+
+	nta.mu.RLock()
+	defer nta.mu.RUnlock()
+
 	for _, p := range nta.state.Players {
 		if p.ID == playerID {
 			return p.GameIdx
@@ -5,7 +5,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/d-protocol/pokerlib"
 	"github.com/d-protocol/pokerlib/table"
+	"github.com/d-protocol/pokertable"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -54,12 +56,10 @@ func Test_NativeTableAdapter_Basic(t *testing.T) {
 	wg.Add(1)
 	nt.OnStateUpdated(func(s *table.State) {
 
-		// Update table state via adapter
-		go func() {
-			for _, a := range actors {
-				a.GetTable().(*NativeTableAdapter).UpdateNativeState(s)
-			}
-		}()
+		// Update table state via adapter, states are applied in order by worker of each adapter
+		for _, a := range actors {
+			assert.Nil(t, a.GetTable().(*NativeTableAdapter).EnqueueNativeState(s))
+		}
 
 		if s.Status == "playing" && s.GameState.Status.CurrentEvent == "GameClosed" {
 			t.Logf("GameClosed (id=%s, playable_players=%d)", s.GameState.GameID, nt.GetPlayablePlayerCount())
@@ -78,6 +78,10 @@ func Test_NativeTableAdapter_Basic(t *testing.T) {
 	assert.Nil(t, nt.Start())
 
 	wg.Wait()
+
+	for _, a := range actors {
+		a.GetTable().(*NativeTableAdapter).Close()
+	}
 }
 
 func Test_NativeTableAdapter_Join_Slowly(t *testing.T) {
@@ -106,6 +110,7 @@ func Test_NativeTableAdapter_Join_Slowly(t *testing.T) {
 
 	// Preparing actors
 	actors := make([]Actor, 0)
+	var actorsMu sync.Mutex
 
 	go func() {
 		for id, bankroll := range players {
@@ -128,7 +133,9 @@ func Test_NativeTableAdapter_Join_Slowly(t *testing.T) {
 			bot := NewBotRunner(id)
 			a.SetRunner(bot)
 
+			actorsMu.Lock()
 			actors = append(actors, a)
+			actorsMu.Unlock()
 
 			time.Sleep(2 * time.Millisecond)
 		}
@@ -177,15 +184,62 @@ func Test_NativeTableAdapter_Join_Slowly(t *testing.T) {
 			wg.Done()
 		}
 
-		// Update table state via adapter
-		go func() {
-			for _, a := range actors {
-				go a.GetTable().(*NativeTableAdapter).UpdateNativeState(s)
-			}
-		}()
+		// Update table state via adapter, states are applied in order by worker of each adapter
+		actorsMu.Lock()
+		for _, a := range actors {
+			assert.Nil(t, a.GetTable().(*NativeTableAdapter).EnqueueNativeState(s))
+		}
+		actorsMu.Unlock()
 	})
 
 	assert.Nil(t, nt.Start())
 
 	wg.Wait()
+
+	actorsMu.Lock()
+	for _, a := range actors {
+		a.GetTable().(*NativeTableAdapter).Close()
+	}
+	actorsMu.Unlock()
+}
+
+type recordingRunner struct {
+	updatedAt []int64
+}
+
+func (r *recordingRunner) SetActor(a Actor) {}
+
+func (r *recordingRunner) UpdateTableState(t *pokertable.Table) error {
+	r.updatedAt = append(r.updatedAt, t.State.GameState.UpdatedAt)
+	return nil
+}
+
+func Test_NativeTableAdapter_OrderedDelivery(t *testing.T) {
+
+	ta := NewNativeTableAdapter(nil)
+	ta.SetQueueTimeout(time.Second)
+
+	r := &recordingRunner{}
+	a := NewActor()
+	a.SetAdapter(ta)
+	a.SetRunner(r)
+
+	expected := make([]int64, 0)
+	for i := 0; i < DefaultStateQueueSize*2; i++ {
+
+		s := table.NewState()
+		s.Status = "playing"
+		s.Options = table.NewOptions()
+		s.GameState = &pokerlib.GameState{
+			UpdatedAt: int64(i),
+		}
+
+		assert.Nil(t, ta.EnqueueNativeState(s))
+		expected = append(expected, int64(i))
+	}
+
+	ta.Close()
+
+	assert.Equal(t, expected, r.updatedAt)
+	assert.Equal(t, int64(len(expected)-1), ta.GetGameState().UpdatedAt)
 }
@@ -0,0 +1,79 @@
+package pokerlib
+
+// ButtonRotator keeps track of the dealer button across hands, so that positions of players don't
+// have to be assigned by hand for every hand.
+type ButtonRotator struct {
+	dealer int
+	hands  int
+}
+
+func NewButtonRotator() *ButtonRotator {
+	return &ButtonRotator{
+		dealer: -1,
+	}
+}
+
+// Dealer returns seat of the current dealer, -1 if button was never rotated.
+func (br *ButtonRotator) Dealer() int {
+	return br.dealer
+}
+
+// TotalHands returns the number of hands which button was rotated for.
+func (br *ButtonRotator) TotalHands() int {
+	return br.hands
+}
+
+// RotateButton advances button to the next seat which is able to play, then assigns dealer, small
+// blind and big blind to players for the next hand. Empty seats and players who have no chips are
+// skipped, and dealer posts small blind in heads-up.
+func (br *ButtonRotator) RotateButton(players []*PlayerSetting) error {
+
+	eligible := func(idx int) bool {
+		p := players[idx]
+		return !p.Empty && p.Bankroll > 0
+	}
+
+	// Seats able to play, in order from the one next to the current dealer
+	seats := make([]int, 0, len(players))
+	for i := 1; i <= len(players); i++ {
+		idx := (br.dealer + i + len(players)) % len(players)
+		if eligible(idx) {
+			seats = append(seats, idx)
+		}
+	}
+
+	if len(seats) < 2 {
+		return ErrInsufficientNumberOfPlayers
+	}
+
+	// Clear positions of the last hand
+	for _, p := range players {
+		positions := make([]string, 0, len(p.Positions))
+		for _, position := range p.Positions {
+			switch position {
+			case "dealer", "sb", "bb":
+				continue
+			}
+
+			positions = append(positions, position)
+		}
+
+		p.Positions = positions
+	}
+
+	dealer := seats[0]
+	players[dealer].Positions = append(players[dealer].Positions, "dealer")
+
+	if len(seats) == 2 {
+		players[dealer].Positions = append(players[dealer].Positions, "sb")
+		players[seats[1]].Positions = append(players[seats[1]].Positions, "bb")
+	} else {
+		players[seats[1]].Positions = append(players[seats[1]].Positions, "sb")
+		players[seats[2]].Positions = append(players[seats[2]].Positions, "bb")
+	}
+
+	br.dealer = dealer
+	br.hands++
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package pokerlib
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Button_Rotate(t *testing.T) {
+
+	players := []*PlayerSetting{
+		NewPlayer(10000),
+		NewPlayer(10000),
+		NewPlayer(0), // busted
+		NewPlayer(10000),
+	}
+
+	br := NewButtonRotator()
+	assert.Equal(t, -1, br.Dealer())
+
+	expected := [][3]int{
+		{0, 1, 3},
+		{1, 3, 0},
+		{3, 0, 1},
+		{0, 1, 3},
+	}
+
+	for i, seats := range expected {
+		assert.Nil(t, br.RotateButton(players))
+		assert.Equal(t, seats[0], br.Dealer())
+		assert.Equal(t, i+1, br.TotalHands())
+
+		assert.Equal(t, []string{"dealer"}, players[seats[0]].Positions)
+		assert.Equal(t, []string{"sb"}, players[seats[1]].Positions)
+		assert.Equal(t, []string{"bb"}, players[seats[2]].Positions)
+		assert.Empty(t, players[2].Positions)
+
+		opts := NewStandardGameOptions()
+		opts.Deck = NewStandardDeckCards()
+		opts.Players = players
+
+		g := NewGame(opts)
+		assert.Nil(t, g.Start())
+		assert.Equal(t, seats[0], g.Dealer().SeatIndex())
+		assert.Equal(t, seats[1], g.SmallBlind().SeatIndex())
+		assert.Equal(t, seats[2], g.BigBlind().SeatIndex())
+	}
+
+	// Heads-up dealer posts small blind
+	players[3].Bankroll = 0
+	assert.Nil(t, br.RotateButton(players))
+	assert.Equal(t, 1, br.Dealer())
+	assert.Equal(t, []string{"dealer", "sb"}, players[1].Positions)
+	assert.Equal(t, []string{"bb"}, players[0].Positions)
+	assert.Empty(t, players[3].Positions)
+
+	players[0].Bankroll = 0
+	assert.Equal(t, ErrInsufficientNumberOfPlayers, br.RotateButton(players))
+}
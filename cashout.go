@@ -0,0 +1,112 @@
+package pokerlib
+
+import (
+	"errors"
+	"math"
+)
+
+var (
+	ErrInvalidCashOutFraction = errors.New("game: invalid cash out fraction")
+	ErrCashOutNotAllowed      = errors.New("game: cash out is not allowed")
+)
+
+// cashOutIterations is the number of runs to estimate equity of player who cashes out
+const cashOutIterations = 5000
+
+// CashOut sells a fraction of the share of pot of a player who is all-in, the player is paid by
+// equity of the fraction right away. The same fraction of chips the player wins at settlement goes
+// to the insurer instead. It returns chips paid to the player.
+func (g *game) CashOut(idx int, fraction float64) (int64, error) {
+
+	ps, err := g.cashOutPlayer(idx, fraction)
+	if err != nil {
+		return 0, err
+	}
+
+	equities, err := g.LiveEquities(cashOutIterations)
+	if err != nil {
+		return 0, err
+	}
+
+	payout := int64(math.Floor(float64(g.cashOutPot(ps)) * equities[idx] * fraction))
+
+	return payout, g.cashOut(ps, fraction, payout)
+}
+
+// cashOutPlayer returns state of the player who is able to cash out the fraction.
+func (g *game) cashOutPlayer(idx int, fraction float64) (*PlayerState, error) {
+
+	if g.IsHandComplete() {
+		return nil, ErrHandComplete
+	}
+
+	if math.IsNaN(fraction) || fraction <= 0 || fraction > 1 {
+		return nil, ErrInvalidCashOutFraction
+	}
+
+	p := g.Player(idx)
+	if p == nil {
+		return nil, ErrNotFoundPlayer
+	}
+
+	ps := p.State()
+	if ps.Fold || ps.StackSize > 0 || ps.CashOutFraction > 0 || g.GetAlivePlayerCount() <= 1 {
+		return nil, ErrCashOutNotAllowed
+	}
+
+	return ps, nil
+}
+
+// cashOut applies the cash out and records it with the fraction, so it can be replayed without
+// estimating equity again.
+func (g *game) cashOut(ps *PlayerState, fraction float64, payout int64) error {
+
+	ps.CashOut = payout
+	ps.CashOutFraction = fraction
+
+	err := g.UpdateLastAction(ps.Idx, "cash_out", payout)
+	if err != nil {
+		return err
+	}
+
+	g.gs.Status.LastAction.Fraction = fraction
+	g.gs.Status.ActionHistory[len(g.gs.Status.ActionHistory)-1].Fraction = fraction
+
+	return nil
+}
+
+// cashOutPot returns chips which the all-in player is able to win once every alive player called,
+// chips of the player which no one is able to call are returned rather than won.
+func (g *game) cashOutPot(ps *PlayerState) int64 {
+
+	committed := func(p *PlayerState) int64 {
+		if p.Fold || p.isDealtOut() {
+			return p.Pot + p.Wager
+		}
+
+		return p.Bankroll
+	}
+
+	stake := int64(0)
+	for _, p := range g.gs.Players {
+		if p.Idx != ps.Idx && !p.Fold && !p.isDealtOut() && committed(p) > stake {
+			stake = committed(p)
+		}
+	}
+
+	if ps.Bankroll < stake {
+		stake = ps.Bankroll
+	}
+
+	total := int64(0)
+	for _, p := range g.gs.Players {
+		chips := committed(p)
+		if chips > stake {
+			chips = stake
+		}
+
+		total += chips
+	}
+
+	return total
+}
@@ -0,0 +1,53 @@
+package pokerlib
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCashOut(t *testing.T) {
+
+	opts := newTestGameOptions(1000, 1000, 1000, 1000)
+	opts.PresetBoard = []string{"SA", "D7", "C2", "H9", "D3"}
+	opts.Players[0].PresetHoleCards = []string{"C4", "H5"}
+	opts.Players[1].PresetHoleCards = []string{"C6", "H8"}
+	opts.Players[2].PresetHoleCards = []string{"SQ", "HQ"}
+	opts.Players[3].PresetHoleCards = []string{"CA", "HK"}
+
+	g := startTestGame(t, opts)
+
+	// No one is all-in yet
+	_, err := g.CashOut(3, 0.5)
+	assert.ErrorIs(t, err, ErrCashOutNotAllowed)
+
+	assert.Nil(t, g.Allin())
+	assert.Nil(t, g.Fold())
+	assert.Nil(t, g.Fold())
+
+	_, err = g.CashOut(3, 0)
+	assert.ErrorIs(t, err, ErrInvalidCashOutFraction)
+	_, err = g.CashOut(3, 1.5)
+	assert.ErrorIs(t, err, ErrInvalidCashOutFraction)
+	_, err = g.CashOut(2, 0.5)
+	assert.ErrorIs(t, err, ErrCashOutNotAllowed)
+
+	// Big slick against queens is close to a coinflip, pot is 2005 once all-in is called
+	payout, err := g.CashOut(3, 0.5)
+	assert.Nil(t, err)
+	assert.InDelta(t, 0.5*0.45*2005, payout, 0.5*0.05*2005)
+	assert.Equal(t, "cash_out", g.GetState().Status.LastAction.Type)
+
+	_, err = g.CashOut(3, 0.5)
+	assert.ErrorIs(t, err, ErrCashOutNotAllowed)
+
+	// Half of winnings go to the insurer
+	assert.Nil(t, g.Call())
+	assert.True(t, g.IsHandComplete())
+
+	r := g.GetState().Result
+	assert.Equal(t, payout, r.Players[3].CashOut)
+	assert.Equal(t, int64(1002), r.Players[3].Sold)
+	assert.Equal(t, 1003+payout, r.Players[3].Final)
+	assert.Equal(t, int64(0), r.Players[2].Final)
+}
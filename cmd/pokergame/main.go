@@ -41,11 +41,12 @@ func main() {
 	printDeck(originalDeck)
 
 	// Process each hand with the dealer button rotation
+	button := pokerlib.NewButtonRotator()
 	for handNum := 0; handNum < playerCount; handNum++ {
 		fmt.Printf("\n======= HAND #%d =======\n", handNum+1)
 
 		// Create standard game options
-		gameOptions := pokerlib.NewStardardGameOptions()
+		gameOptions := pokerlib.NewStandardGameOptions()
 
 		// Setup players
 		playerSettings := make([]*pokerlib.PlayerSetting, playerCount)
@@ -58,13 +59,9 @@ func main() {
 		}
 
 		// Set dealer, small blind and big blind positions, rotating each hand
-		dealerPos := handNum % playerCount
-		sbPos := (dealerPos + 1) % playerCount
-		bbPos := (dealerPos + 2) % playerCount
-
-		playerSettings[dealerPos].Positions = append(playerSettings[dealerPos].Positions, "dealer")
-		playerSettings[sbPos].Positions = append(playerSettings[sbPos].Positions, "sb")
-		playerSettings[bbPos].Positions = append(playerSettings[bbPos].Positions, "bb")
+		if err := button.RotateButton(playerSettings); err != nil {
+			log.Fatalf("Failed to rotate button: %v", err)
+		}
 
 		// Update game options
 		gameOptions.Players = playerSettings
@@ -82,8 +79,9 @@ func main() {
 			printDeck(shuffledDeck)
 		}
 
-		// Use the shuffled deck for the game
+		// Use the shuffled deck for the game as-is
 		gameOptions.Deck = shuffledDeck
+		gameOptions.NoShuffle = true
 		gameOptions.HoleCardsCount = 2
 
 		// Create game
@@ -76,59 +76,106 @@ var CombinationPowerShortDeck = []Combination{
 	CombinationStraightFlush,
 }
 
-func gospersHack(k int, n int) []int {
+// CombinationPowerShortDeckTrips is the short deck rankings of rulesets in which three of a kind
+// beats straight as well.
+var CombinationPowerShortDeckTrips = []Combination{
+	CombinationHighCard,
+	CombinationPair,
+	CombinationTwoPair,
+	CombinationStraight,
+	CombinationThreeOfAKind,
+	CombinationFullHouse,
+	CombinationFlush,
+	CombinationFourOfAKind,
+	CombinationStraightFlush,
+}
 
-	result := make([]int, 0)
+// IsShortDeckRankings reports whether rankings are made for short deck, in which flush beats full
+// house and Ace plays as the rank below Six for the lowest straight, A, 6, 7, 8, 9.
+func IsShortDeckRankings(pr PowerRankings) bool {
+
+	flush := -1
+	fullHouse := -1
+	for i, c := range pr {
+		switch c {
+		case CombinationFlush:
+			flush = i
+		case CombinationFullHouse:
+			fullHouse = i
+		}
+	}
+
+	return flush > fullHouse && fullHouse >= 0
+}
 
-	cur := (1 << k) - 1
-	limit := 1 << n
-	for cur < limit {
-		// do something
-		//fmt.Printf("%0*b\n", n, cur)
-		result = append(result, cur)
+// Combinations returns all k-card combinations of cards in order of card positions. All
+// combinations are carved out of a single backing array to keep allocations low for hot loops.
+func Combinations(cards []string, k int) [][]string {
 
-		lb := cur & -cur
-		r := cur + lb
-		cur = (((r ^ cur) >> 2) / lb) | r
+	n := len(cards)
+	if k < 0 || k > n {
+		return [][]string{}
 	}
 
-	return result
-}
+	count := binomial(n, k)
+	combinations := make([][]string, 0, count)
+	buf := make([]string, count*k)
+
+	// Positions of cards for current combination
+	positions := make([]int, k)
+	for i := range positions {
+		positions[i] = i
+	}
 
-func binaryOnesPositions(value int, n int) []int {
+	for {
+		combination := buf[:k:k]
+		buf = buf[k:]
+		for i, p := range positions {
+			combination[i] = cards[p]
+		}
+
+		combinations = append(combinations, combination)
+
+		// Find the rightmost position which can be moved forward
+		i := k - 1
+		for i >= 0 && positions[i] == n-k+i {
+			i--
+		}
 
-	var positions []int
-	for i := 0; i < n; i++ {
-		if (value>>i)&1 == 1 {
-			positions = append(positions, i)
+		if i < 0 {
+			break
+		}
+
+		positions[i]++
+		for j := i + 1; j < k; j++ {
+			positions[j] = positions[j-1] + 1
 		}
 	}
-	return positions
+
+	return combinations
 }
 
-func GetPossibleCombinations(cards []string, n int) [][]string {
+func binomial(n int, k int) int {
 
-	combinations := make([][]string, 0)
+	if k > n-k {
+		k = n - k
+	}
 
-	total := len(cards)
-	if total <= n {
-		combinations = append(combinations, cards)
-		return combinations
+	result := 1
+	for i := 1; i <= k; i++ {
+		result = result * (n - k + i) / i
 	}
 
-	posBins := gospersHack(n, total)
+	return result
+}
 
-	for _, v := range posBins {
-		positions := binaryOnesPositions(v, total)
-		combination := make([]string, 0)
-		for _, p := range positions {
-			combination = append(combination, cards[p])
-		}
+func GetPossibleCombinations(cards []string, n int) [][]string {
 
-		combinations = append(combinations, combination)
+	if len(cards) <= n {
+		return [][]string{cards}
 	}
 
-	return combinations
+	return Combinations(cards, n)
 }
 
 func GetAllPossibleCombinations(boardCards []string, holeCards []string, holeCardsCount int) [][]string {
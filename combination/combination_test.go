@@ -1,6 +1,7 @@
 package combination
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -53,3 +54,28 @@ func TestGetAllPossibleCombinations_4_HoleCards(t *testing.T) {
 		assert.Equal(t, 5, len(c))
 	}
 }
+
+func TestCombinations(t *testing.T) {
+
+	cards := []string{"S2", "H3", "D4", "C5", "C7", "DT", "DK"}
+
+	combinations := Combinations(cards, 5)
+	assert.Len(t, combinations, 21)
+
+	seen := make(map[string]bool)
+	for _, c := range combinations {
+		assert.Len(t, c, 5)
+
+		key := strings.Join(c, ",")
+		assert.False(t, seen[key], key)
+		seen[key] = true
+	}
+
+	assert.Equal(t, []string{"S2", "H3", "D4", "C5", "C7"}, combinations[0])
+	assert.Equal(t, []string{"D4", "C5", "C7", "DT", "DK"}, combinations[20])
+
+	// Edge cases
+	assert.Equal(t, [][]string{{}}, Combinations(cards, 0))
+	assert.Len(t, Combinations(cards, 7), 1)
+	assert.Empty(t, Combinations(cards, 8))
+}
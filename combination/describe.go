@@ -0,0 +1,128 @@
+package combination
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var RankName = map[int]string{
+	2:  "Two",
+	3:  "Three",
+	4:  "Four",
+	5:  "Five",
+	6:  "Six",
+	7:  "Seven",
+	8:  "Eight",
+	9:  "Nine",
+	10: "Ten",
+	11: "Jack",
+	12: "Queen",
+	13: "King",
+	14: "Ace",
+}
+
+func rankPluralName(rank int) string {
+
+	if rank == 6 {
+		return "Sixes"
+	}
+
+	return RankName[rank] + "s"
+}
+
+// DescribeHand returns the canonical description of combination with kickers if there are any,
+// e.g., "Two Pair, Kings and Tens, Ace kicker".
+func DescribeHand(ps *PowerState) string {
+
+	if ps == nil || len(ps.Cards) == 0 {
+		return ""
+	}
+
+	// Elements with more cards first, then higher rank first
+	elements := make([]*Element, len(ps.Elements))
+	copy(elements, ps.Elements)
+	sort.SliceStable(elements, func(i, j int) bool {
+		if elements[i].Count != elements[j].Count {
+			return elements[i].Count > elements[j].Count
+		}
+
+		return elements[i].Rank > elements[j].Rank
+	})
+
+	// Kickers are cards which are not part of the combination
+	kickers := func(from int) string {
+		if from >= len(elements) {
+			return ""
+		}
+
+		names := make([]string, 0)
+		for _, ele := range elements[from:] {
+			names = append(names, RankName[ele.Rank])
+		}
+
+		if len(names) == 1 {
+			return fmt.Sprintf(", %s kicker", names[0])
+		}
+
+		return fmt.Sprintf(", %s kickers", strings.Join(names, " "))
+	}
+
+	switch ps.Combination {
+	case CombinationStraightFlush:
+		high := straightHighRank(ps.Cards)
+		if high == 14 {
+			return "Royal Flush"
+		}
+
+		return fmt.Sprintf("Straight Flush, %s high", RankName[high])
+	case CombinationFourOfAKind:
+		return fmt.Sprintf("Four of a Kind, %s%s", rankPluralName(elements[0].Rank), kickers(1))
+	case CombinationFullHouse:
+		return fmt.Sprintf("Full House, %s over %s", rankPluralName(elements[0].Rank), rankPluralName(elements[1].Rank))
+	case CombinationFlush:
+		return fmt.Sprintf("Flush, %s high", RankName[elements[0].Rank])
+	case CombinationStraight:
+		return fmt.Sprintf("Straight, %s high", RankName[straightHighRank(ps.Cards)])
+	case CombinationThreeOfAKind:
+		return fmt.Sprintf("Three of a Kind, %s%s", rankPluralName(elements[0].Rank), kickers(1))
+	case CombinationTwoPair:
+		return fmt.Sprintf("Two Pair, %s and %s%s", rankPluralName(elements[0].Rank), rankPluralName(elements[1].Rank), kickers(2))
+	case CombinationPair:
+		return fmt.Sprintf("Pair of %s%s", rankPluralName(elements[0].Rank), kickers(1))
+	}
+
+	return fmt.Sprintf("High Card, %s%s", RankName[elements[0].Rank], kickers(1))
+}
+
+// straightHighRank returns the highest rank of straight, which is 5 if Ace is played as one, or 9
+// if Ace is played below Six of short deck.
+func straightHighRank(cards []*Card) int {
+
+	high := 0
+	hasFive := false
+	hasNine := false
+	for _, c := range cards {
+		if c.Rank > high {
+			high = c.Rank
+		}
+
+		switch c.Rank {
+		case 5:
+			hasFive = true
+		case 9:
+			hasNine = true
+		}
+	}
+
+	if high == 14 && hasFive {
+		return 5
+	}
+
+	// Broadway has no Nine, so that Ace-high straight with Nine is A, 6, 7, 8, 9
+	if high == 14 && hasNine {
+		return 9
+	}
+
+	return high
+}
@@ -0,0 +1,46 @@
+package combination
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescribeHand(t *testing.T) {
+
+	cases := []struct {
+		cards       []string
+		description string
+	}{
+		{[]string{"SA", "HK", "D9", "C7", "C4"}, "High Card, Ace, King Nine Seven Four kickers"},
+		{[]string{"SK", "HK", "DA", "C9", "C4"}, "Pair of Kings, Ace Nine Four kickers"},
+		{[]string{"SK", "HK", "DT", "CT", "CA"}, "Two Pair, Kings and Tens, Ace kicker"},
+		{[]string{"S6", "H6", "D6", "CA", "C2"}, "Three of a Kind, Sixes, Ace Two kickers"},
+		{[]string{"S5", "H6", "D7", "C8", "C9"}, "Straight, Nine high"},
+		{[]string{"SA", "H2", "D3", "C4", "C5"}, "Straight, Five high"},
+		{[]string{"C2", "C9", "CJ", "C5", "CA"}, "Flush, Ace high"},
+		{[]string{"ST", "HT", "DK", "CK", "SK"}, "Full House, Kings over Tens"},
+		{[]string{"SQ", "HQ", "DQ", "CQ", "SA"}, "Four of a Kind, Queens, Ace kicker"},
+		{[]string{"H5", "H6", "H7", "H8", "H9"}, "Straight Flush, Nine high"},
+		{[]string{"HT", "HJ", "HQ", "HK", "HA"}, "Royal Flush"},
+
+		// Partial hand has no kicker
+		{[]string{"SK", "HK"}, "Pair of Kings"},
+	}
+
+	for _, c := range cases {
+		ps := CalculatePower(CombinationPowerStandard, c.cards)
+		assert.Equal(t, c.description, DescribeHand(ps), c.cards)
+	}
+
+	assert.Equal(t, "", DescribeHand(nil))
+}
+
+func TestDescribeHand_ShortDeck(t *testing.T) {
+
+	ps := CalculatePower(CombinationPowerShortDeck, []string{"SA", "H6", "D7", "C8", "C9"})
+	assert.Equal(t, "Straight, Nine high", DescribeHand(ps))
+
+	ps = CalculatePower(CombinationPowerShortDeck, []string{"HA", "H6", "H7", "H8", "H9"})
+	assert.Equal(t, "Straight Flush, Nine high", DescribeHand(ps))
+}
@@ -0,0 +1,187 @@
+package combination
+
+import "math/bits"
+
+// Rank of fast evaluation is made of combination and ranks of cards which break ties:
+//
+//	combination(4 bits) | rank 1(4 bits) | rank 2(4 bits) | ... | rank 5(4 bits)
+//
+// Ranks are aligned to 0 (Deuce) and ordered by importance, e.g., rank of trips, rank of pair and
+// then kickers, so that ranks are comparable as integers.
+const fastCombinationShift = 20
+
+var (
+	// fastRankIndex and fastSuitIndex convert symbols of card to indexes, -1 if symbol is invalid
+	fastRankIndex [256]int8
+	fastSuitIndex [256]int8
+
+	// fastStraightTable is the rank of highest card of straight for ranks mask, -1 if no straight
+	fastStraightTable [1 << 13]int8
+
+	// fastTopFiveTable is the ranks of the highest five cards for ranks mask
+	fastTopFiveTable [1 << 13]int32
+)
+
+func init() {
+
+	for i := range fastRankIndex {
+		fastRankIndex[i] = -1
+		fastSuitIndex[i] = -1
+	}
+
+	for symbol, rank := range CardRank {
+		fastRankIndex[symbol[0]] = int8(rank - 2)
+	}
+
+	for idx, symbol := range SuitSymbol {
+		fastSuitIndex[symbol[0]] = int8(idx - 1)
+	}
+
+	for mask := range fastStraightTable {
+		fastStraightTable[mask] = -1
+
+		// From Ace-high to Six-high
+		for top := 12; top >= 4; top-- {
+			straight := 0x1f << (top - 4)
+			if mask&straight == straight {
+				fastStraightTable[mask] = int8(top)
+				break
+			}
+		}
+
+		// A, 2, 3, 4, 5
+		wheel := 1<<12 | 0xf
+		if fastStraightTable[mask] == -1 && mask&wheel == wheel {
+			fastStraightTable[mask] = 3
+		}
+
+		fastTopFiveTable[mask] = fastKickers(uint16(mask), 5, 16)
+	}
+}
+
+// fastKickers returns the highest n ranks of mask which are placed from shift downward.
+func fastKickers(mask uint16, n int, shift int) int32 {
+
+	v := int32(0)
+	for i := 0; i < n && mask != 0; i++ {
+		r := bits.Len16(mask) - 1
+		v |= int32(r) << shift
+		shift -= 4
+		mask &^= 1 << r
+	}
+
+	return v
+}
+
+func fastHighest(mask uint16) int {
+	return bits.Len16(mask) - 1
+}
+
+// EvaluateHand returns the score of the best five cards with standard rankings, which is the
+// naive way to evaluate a hand by calculating power of all combinations.
+func EvaluateHand(cards []string) uint64 {
+
+	best := uint64(0)
+	for _, c := range Combinations(cards, 5) {
+		ps := CalculatePower(CombinationPowerStandard, c)
+		if ps.Score > best {
+			best = ps.Score
+		}
+	}
+
+	return best
+}
+
+// EvaluateHandFast returns the rank of the best five cards out of 5 to 7 cards with standard
+// rankings, which is ordered the same way as score of EvaluateHand. Rank tables are used instead
+// of calculating power of all combinations, so that it is suitable for simulations. It returns -1
+// if the number of cards is out of range or there is an invalid card.
+func EvaluateHandFast(cards []string) int32 {
+
+	if len(cards) < 5 || len(cards) > 7 {
+		return -1
+	}
+
+	var suits [4]uint16
+	var counts [13]uint8
+	all := uint16(0)
+	for _, c := range cards {
+
+		if len(c) != 2 {
+			return -1
+		}
+
+		s := fastSuitIndex[c[0]]
+		r := fastRankIndex[c[1]]
+		if s < 0 || r < 0 {
+			return -1
+		}
+
+		suits[s] |= 1 << r
+		counts[r]++
+		all |= 1 << r
+	}
+
+	// Flush excludes four of a kind and full house with no more than 7 cards
+	for _, mask := range suits {
+
+		if bits.OnesCount16(mask) < 5 {
+			continue
+		}
+
+		if top := fastStraightTable[mask]; top >= 0 {
+			return int32(CombinationStraightFlush)<<fastCombinationShift | int32(top)<<16
+		}
+
+		return int32(CombinationFlush)<<fastCombinationShift | fastTopFiveTable[mask]
+	}
+
+	var quads, trips, pairs uint16
+	for r, count := range counts {
+		switch count {
+		case 4:
+			quads |= 1 << r
+		case 3:
+			trips |= 1 << r
+		case 2:
+			pairs |= 1 << r
+		}
+	}
+
+	if quads != 0 {
+		q := fastHighest(quads)
+		return int32(CombinationFourOfAKind)<<fastCombinationShift | int32(q)<<16 | fastKickers(all&^(1<<q), 1, 12)
+	}
+
+	if trips != 0 {
+
+		// The second trips could be used as a pair of full house
+		t := fastHighest(trips)
+		if rest := (trips &^ (1 << t)) | pairs; rest != 0 {
+			return int32(CombinationFullHouse)<<fastCombinationShift | int32(t)<<16 | int32(fastHighest(rest))<<12
+		}
+	}
+
+	if top := fastStraightTable[all]; top >= 0 {
+		return int32(CombinationStraight)<<fastCombinationShift | int32(top)<<16
+	}
+
+	if trips != 0 {
+		t := fastHighest(trips)
+		return int32(CombinationThreeOfAKind)<<fastCombinationShift | int32(t)<<16 | fastKickers(all&^(1<<t), 2, 12)
+	}
+
+	if bits.OnesCount16(pairs) >= 2 {
+		p1 := fastHighest(pairs)
+		p2 := fastHighest(pairs &^ (1 << p1))
+		kicker := fastKickers(all&^(1<<p1|1<<p2), 1, 8)
+		return int32(CombinationTwoPair)<<fastCombinationShift | int32(p1)<<16 | int32(p2)<<12 | kicker
+	}
+
+	if pairs != 0 {
+		p := fastHighest(pairs)
+		return int32(CombinationPair)<<fastCombinationShift | int32(p)<<16 | fastKickers(all&^(1<<p), 3, 12)
+	}
+
+	return int32(CombinationHighCard)<<fastCombinationShift | fastTopFiveTable[all]
+}
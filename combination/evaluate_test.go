@@ -0,0 +1,116 @@
+package combination
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func randomHands(count int, size int) [][]string {
+
+	r := rand.New(rand.NewSource(1))
+	deck := newStandardDeck()
+
+	hands := make([][]string, 0, count)
+	for i := 0; i < count; i++ {
+		r.Shuffle(len(deck), func(i, j int) {
+			deck[i], deck[j] = deck[j], deck[i]
+		})
+
+		hands = append(hands, append([]string{}, deck[:size]...))
+	}
+
+	return hands
+}
+
+func TestEvaluateHandFast(t *testing.T) {
+
+	type evaluation struct {
+		cards []string
+		naive uint64
+		fast  int32
+	}
+
+	for _, size := range []int{5, 6, 7} {
+
+		evaluations := make([]evaluation, 0)
+		for _, cards := range randomHands(3000, size) {
+			evaluations = append(evaluations, evaluation{
+				cards: cards,
+				naive: EvaluateHand(cards),
+				fast:  EvaluateHandFast(cards),
+			})
+		}
+
+		sort.Slice(evaluations, func(i, j int) bool {
+			return evaluations[i].naive < evaluations[j].naive
+		})
+
+		// Both ways have to be in the same order
+		for i := 1; i < len(evaluations); i++ {
+			prev, cur := evaluations[i-1], evaluations[i]
+			if prev.naive == cur.naive {
+				assert.Equal(t, prev.fast, cur.fast, "%v %v", prev.cards, cur.cards)
+			} else {
+				assert.Less(t, prev.fast, cur.fast, "%v %v", prev.cards, cur.cards)
+			}
+		}
+	}
+}
+
+func TestEvaluateHandFast_Combinations(t *testing.T) {
+
+	hands := [][]string{
+		{"S7", "H2", "D3", "C4", "S5", "DJ", "CK"},
+		{"S7", "H2", "D3", "C4", "SA", "DJ", "C5"},
+		{"S7", "H2", "D3", "C4", "S5", "D6", "CK"},
+		{"S7", "S2", "S3", "H4", "SJ", "D6", "S9"},
+		{"S7", "H7", "D7", "C4", "S4", "D4", "CK"},
+		{"S7", "H7", "D7", "C7", "S4", "D4", "C4"},
+		{"S7", "S3", "S4", "S5", "S6", "D7", "C7"},
+	}
+
+	expected := []Combination{
+		CombinationHighCard,
+		CombinationStraight,
+		CombinationStraight,
+		CombinationFlush,
+		CombinationFullHouse,
+		CombinationFourOfAKind,
+		CombinationStraightFlush,
+	}
+
+	for i, cards := range hands {
+		rank := EvaluateHandFast(cards)
+		assert.Equal(t, expected[i], Combination(rank>>fastCombinationShift), cards)
+	}
+
+	// Wheel is the lowest straight
+	assert.Less(t, EvaluateHandFast(hands[1]), EvaluateHandFast(hands[2]))
+
+	// Invalid cards
+	assert.Equal(t, int32(-1), EvaluateHandFast([]string{"S7", "H2", "D3", "C4"}))
+	assert.Equal(t, int32(-1), EvaluateHandFast([]string{"S7", "H2", "D3", "C4", "X5"}))
+}
+
+func BenchmarkEvaluateHand(b *testing.B) {
+
+	hands := randomHands(1000, 7)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		EvaluateHand(hands[i%len(hands)])
+	}
+}
+
+func BenchmarkEvaluateHandFast(b *testing.B) {
+
+	hands := randomHands(1000, 7)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		EvaluateHandFast(hands[i%len(hands)])
+	}
+}
@@ -0,0 +1,95 @@
+package combination
+
+// HandResult is the best five cards of a hand and its power.
+type HandResult struct {
+	Combination Combination
+	Score       uint64
+	Cards       []string
+	Description string
+}
+
+func newStandardDeck() []string {
+
+	cards := make([]string, 0, 52)
+	for _, suit := range rangeSuits {
+		for rank := 2; rank <= 14; rank++ {
+			cards = append(cards, suit+CardSymbol[rank])
+		}
+	}
+
+	return cards
+}
+
+// bestHand returns the strongest combination which is made of board and hole cards, exactly
+// holeCardsNeeded hole cards are used unless it is 0.
+func bestHand(pr PowerRankings, board []string, holeCards []string, holeCardsNeeded int) *PowerState {
+
+	var best *PowerState
+	for _, cards := range GetAllPossibleCombinations(board, holeCards, holeCardsNeeded) {
+		ps := CalculatePower(pr, cards)
+		if best == nil || ps.Score > best.Score {
+			best = ps
+		}
+	}
+
+	return best
+}
+
+// Nuts returns the strongest hand achievable on the board with standard rankings, and all hole cards
+// which make it. Hole cards are as many as holeCardsNeeded which have to be used (e.g., 2 for Omaha),
+// or 2 cards which can be used in any way if it is 0 (e.g., Hold'em).
+func Nuts(board []string, holeCardsNeeded int) (*HandResult, [][]string) {
+
+	count := holeCardsNeeded
+	if count == 0 {
+		count = 2
+	}
+
+	onBoard := make(map[string]bool, len(board))
+	for _, c := range board {
+		onBoard[c] = true
+	}
+
+	remaining := make([]string, 0, 52)
+	for _, c := range newStandardDeck() {
+		if !onBoard[c] {
+			remaining = append(remaining, c)
+		}
+	}
+
+	var nuts *PowerState
+	combos := make([][]string, 0)
+	for _, holeCards := range GetPossibleCombinations(remaining, count) {
+
+		ps := bestHand(CombinationPowerStandard, board, holeCards, holeCardsNeeded)
+		if ps == nil {
+			continue
+		}
+
+		switch {
+		case nuts == nil || ps.Score > nuts.Score:
+			nuts = ps
+			combos = [][]string{holeCards}
+		case ps.Score == nuts.Score:
+			combos = append(combos, holeCards)
+		}
+	}
+
+	if nuts == nil {
+		return nil, combos
+	}
+
+	cards := make([]string, 0, len(nuts.Cards))
+	for _, c := range nuts.Cards {
+		cards = append(cards, c.ToString())
+	}
+
+	result := &HandResult{
+		Combination: nuts.Combination,
+		Score:       nuts.Score,
+		Cards:       cards,
+		Description: DescribeHand(nuts),
+	}
+
+	return result, combos
+}
@@ -0,0 +1,36 @@
+package combination
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNuts(t *testing.T) {
+
+	// Monotone board without straight flush draw
+	result, combos := Nuts([]string{"SK", "S8", "S3"}, 0)
+	assert.Equal(t, CombinationFlush, result.Combination)
+	assert.Equal(t, "Flush, Ace high", result.Description)
+	assert.Equal(t, [][]string{{"SQ", "SA"}}, combos)
+
+	// Straight flush
+	result, combos = Nuts([]string{"H9", "HT", "HJ", "S2", "C3"}, 0)
+	assert.Equal(t, CombinationStraightFlush, result.Combination)
+	assert.Equal(t, "Straight Flush, King high", result.Description)
+	assert.Equal(t, [][]string{{"HQ", "HK"}}, combos)
+
+	// Quads with paired board
+	result, combos = Nuts([]string{"SK", "HK", "D7", "C2", "H9"}, 0)
+	assert.Equal(t, CombinationFourOfAKind, result.Combination)
+	assert.Equal(t, [][]string{{"DK", "CK"}}, combos)
+
+	// Exactly two hole cards have to be used, so that royal flush can't be made with one card
+	result, combos = Nuts([]string{"SA", "SK", "SQ", "SJ", "D2"}, 2)
+	assert.Equal(t, "Straight Flush, King high", result.Description)
+	assert.Equal(t, [][]string{{"S9", "ST"}}, combos)
+
+	result, combos = Nuts([]string{"SA", "SK", "SQ", "SJ", "D2"}, 0)
+	assert.Equal(t, "Royal Flush", result.Description)
+	assert.Len(t, combos, 46)
+}
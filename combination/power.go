@@ -39,7 +39,7 @@ func CalculatePower(pr PowerRankings, cardSymbols []string) *PowerState {
 	}
 
 	// Straight
-	if isStraight(cards) {
+	if isStraight(cards, IsShortDeckRankings(pr)) {
 		if ps.Combination == CombinationFlush {
 			ps.Combination = CombinationStraightFlush
 		} else {
@@ -103,8 +103,8 @@ func CalculatePowerScore(ps *PowerState) uint64 {
 			totalPoint += e.Rank
 		}
 
-		// A, 2, 3, 4, 5
-		if maxRank == 14 && totalPoint == 28 {
+		// A, 2, 3, 4, 5 or A, 6, 7, 8, 9 of short deck
+		if maxRank == 14 && (totalPoint == 28 || totalPoint == 44) {
 			score = 0
 		} else {
 			// >= 2, 3, 4, 5, 6
@@ -141,7 +141,9 @@ func isFlush(cards []*Card) bool {
 	return true
 }
 
-func isStraight(cards []*Card) bool {
+// isStraight checks if sorted cards are straight. Ace could be played as the rank below Six rather
+// than Two for short deck.
+func isStraight(cards []*Card, shortDeck bool) bool {
 
 	if len(cards) != 5 {
 		return false
@@ -158,6 +160,9 @@ func isStraight(cards []*Card) bool {
 	if cards[0].Rank == 14 && cards[1].Rank == 5 {
 		// assume that lowest rank of straight
 		restOfCards = cards[1:5]
+	} else if shortDeck && cards[0].Rank == 14 && cards[1].Rank == 9 {
+		// A, 6, 7, 8, 9 is the lowest straight of short deck
+		restOfCards = cards[1:5]
 	}
 
 	// Check each rank
@@ -297,3 +297,54 @@ func TestCalculatePower_Straight_Invalid(t *testing.T) {
 	assert.Equal(t, ps.Combination, CombinationHighCard)
 	assert.Equal(t, len(ps.Elements), 5)
 }
+
+func TestCalculatePower_ShortDeck_FlushOverFullHouse(t *testing.T) {
+
+	flush := []string{"C6", "C8", "CT", "CQ", "CK"}
+	fullHouse := []string{"SA", "HA", "DA", "CK", "SK"}
+
+	// Full house beats flush with standard rankings
+	assert.Greater(t, CalculatePower(CombinationPowerStandard, fullHouse).Score, CalculatePower(CombinationPowerStandard, flush).Score)
+
+	// Flush beats full house with short deck rankings
+	assert.Greater(t, CalculatePower(CombinationPowerShortDeck, flush).Score, CalculatePower(CombinationPowerShortDeck, fullHouse).Score)
+	assert.Greater(t, CalculatePower(CombinationPowerShortDeckTrips, flush).Score, CalculatePower(CombinationPowerShortDeckTrips, fullHouse).Score)
+}
+
+func TestCalculatePower_ShortDeck_TripsOverStraight(t *testing.T) {
+
+	trips := []string{"S6", "H6", "D6", "C7", "C8"}
+	straight := []string{"ST", "HJ", "DQ", "CK", "CA"}
+
+	assert.Greater(t, CalculatePower(CombinationPowerShortDeck, straight).Score, CalculatePower(CombinationPowerShortDeck, trips).Score)
+	assert.Greater(t, CalculatePower(CombinationPowerShortDeckTrips, trips).Score, CalculatePower(CombinationPowerShortDeckTrips, straight).Score)
+}
+
+func TestCalculatePower_ShortDeck_Wheel(t *testing.T) {
+
+	// A, 6, 7, 8, 9 is the lowest straight of short deck
+	wheel := CalculatePower(CombinationPowerShortDeck, []string{"SA", "H6", "D7", "C8", "C9"})
+	assert.Equal(t, CombinationStraight, wheel.Combination)
+
+	lowest := CalculatePower(CombinationPowerShortDeck, []string{"S6", "H7", "D8", "C9", "CT"})
+	assert.Equal(t, CombinationStraight, lowest.Combination)
+	assert.Greater(t, lowest.Score, wheel.Score)
+
+	trips := CalculatePower(CombinationPowerShortDeck, []string{"SA", "HA", "DA", "CK", "CQ"})
+	assert.Greater(t, wheel.Score, trips.Score)
+
+	// Straight flush
+	ps := CalculatePower(CombinationPowerShortDeck, []string{"HA", "H6", "H7", "H8", "H9"})
+	assert.Equal(t, CombinationStraightFlush, ps.Combination)
+
+	// It is not straight with standard rankings
+	ps = CalculatePower(CombinationPowerStandard, []string{"SA", "H6", "D7", "C8", "C9"})
+	assert.Equal(t, CombinationHighCard, ps.Combination)
+}
+
+func TestIsShortDeckRankings(t *testing.T) {
+	assert.False(t, IsShortDeckRankings(CombinationPowerStandard))
+	assert.True(t, IsShortDeckRankings(CombinationPowerShortDeck))
+	assert.True(t, IsShortDeckRankings(CombinationPowerShortDeckTrips))
+	assert.False(t, IsShortDeckRankings(nil))
+}
@@ -0,0 +1,211 @@
+package combination
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	ErrInvalidRange = errors.New("combination: invalid range")
+)
+
+// Suits in the order which combos are generated
+var rangeSuits = []string{"S", "H", "D", "C"}
+
+// handClass is a starting hand without suits, e.g., "AKs". Kind is "s" for suited, "o" for offsuit
+// and empty for both, which is always empty for pairs.
+type handClass struct {
+	high int
+	low  int
+	kind string
+}
+
+func (hc handClass) isPair() bool {
+	return hc.high == hc.low
+}
+
+func parseHandClass(notation string) (handClass, error) {
+
+	hc := handClass{}
+
+	if len(notation) != 2 && len(notation) != 3 {
+		return hc, ErrInvalidRange
+	}
+
+	high, ok := CardRank[notation[0:1]]
+	if !ok {
+		return hc, ErrInvalidRange
+	}
+
+	low, ok := CardRank[notation[1:2]]
+	if !ok {
+		return hc, ErrInvalidRange
+	}
+
+	if high < low {
+		high, low = low, high
+	}
+
+	hc.high = high
+	hc.low = low
+
+	if len(notation) == 3 {
+		hc.kind = notation[2:3]
+		if (hc.kind != "s" && hc.kind != "o") || hc.isPair() {
+			return hc, ErrInvalidRange
+		}
+	}
+
+	return hc, nil
+}
+
+// combos returns all hole cards of the hand class.
+func (hc handClass) combos() [][]string {
+
+	combos := make([][]string, 0)
+	high := CardSymbol[hc.high]
+	low := CardSymbol[hc.low]
+
+	for i, s1 := range rangeSuits {
+		for j, s2 := range rangeSuits {
+
+			switch {
+			case hc.isPair():
+				if i >= j {
+					continue
+				}
+			case hc.kind == "s":
+				if i != j {
+					continue
+				}
+			case hc.kind == "o":
+				if i == j {
+					continue
+				}
+			}
+
+			combos = append(combos, []string{s1 + high, s2 + low})
+		}
+	}
+
+	return combos
+}
+
+// expandRange returns hand classes of a single expression, e.g., "QQ+", "ATs+", "A5s-A2s" or "T9s-76s".
+func expandRange(notation string) ([]handClass, error) {
+
+	classes := make([]handClass, 0)
+
+	// Dash range
+	if parts := strings.Split(notation, "-"); len(parts) == 2 {
+
+		from, err := parseHandClass(parts[0])
+		if err != nil {
+			return nil, err
+		}
+
+		to, err := parseHandClass(parts[1])
+		if err != nil {
+			return nil, err
+		}
+
+		if from.kind != to.kind || from.isPair() != to.isPair() {
+			return nil, ErrInvalidRange
+		}
+
+		if from.high < to.high || (from.high == to.high && from.low < to.low) {
+			from, to = to, from
+		}
+
+		switch {
+		case from.isPair():
+			for r := to.high; r <= from.high; r++ {
+				classes = append(classes, handClass{high: r, low: r})
+			}
+		case from.high == to.high:
+			// The highest card is fixed, e.g., "A5s-A2s"
+			for r := to.low; r <= from.low; r++ {
+				classes = append(classes, handClass{high: from.high, low: r, kind: from.kind})
+			}
+		case from.high-from.low == to.high-to.low:
+			// Both cards move together, e.g., "T9s-76s"
+			for r := to.high; r <= from.high; r++ {
+				classes = append(classes, handClass{high: r, low: r - (from.high - from.low), kind: from.kind})
+			}
+		default:
+			return nil, ErrInvalidRange
+		}
+
+		return classes, nil
+	} else if len(parts) > 2 {
+		return nil, ErrInvalidRange
+	}
+
+	// Plus range
+	if strings.HasSuffix(notation, "+") {
+
+		hc, err := parseHandClass(strings.TrimSuffix(notation, "+"))
+		if err != nil {
+			return nil, err
+		}
+
+		if hc.isPair() {
+			for r := hc.high; r <= CardRank["A"]; r++ {
+				classes = append(classes, handClass{high: r, low: r})
+			}
+
+			return classes, nil
+		}
+
+		// Kicker goes up to the rank below the highest card, e.g., "ATs+"
+		for r := hc.low; r < hc.high; r++ {
+			classes = append(classes, handClass{high: hc.high, low: r, kind: hc.kind})
+		}
+
+		return classes, nil
+	}
+
+	hc, err := parseHandClass(notation)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(classes, hc), nil
+}
+
+// ParseRange expands standard range notation into hole cards. Expressions are separated by commas,
+// e.g., "QQ+, AKs, A5s-A2s, T9s-76s, AJo". Hand without suitedness, e.g., "AK", includes both suited
+// and offsuit combos. Duplicate combos are returned only once.
+func ParseRange(notation string) ([][]string, error) {
+
+	combos := make([][]string, 0)
+	seen := make(map[string]bool)
+
+	for _, expr := range strings.Split(notation, ",") {
+
+		expr = strings.TrimSpace(expr)
+		if len(expr) == 0 {
+			return nil, ErrInvalidRange
+		}
+
+		classes, err := expandRange(expr)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, hc := range classes {
+			for _, combo := range hc.combos() {
+
+				key := combo[0] + combo[1]
+				if seen[key] {
+					continue
+				}
+
+				seen[key] = true
+				combos = append(combos, combo)
+			}
+		}
+	}
+
+	return combos, nil
+}
@@ -0,0 +1,74 @@
+package combination
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRange(t *testing.T) {
+
+	cases := []struct {
+		notation string
+		count    int
+	}{
+		{"QQ", 6},
+		{"QQ+", 18},
+		{"JJ-88", 24},
+		{"AKs", 4},
+		{"AKo", 12},
+		{"AK", 16},
+		{"ATs+", 16},
+		{"A5s-A2s", 16},
+		{"T9s-76s", 16},
+		{"KQo-JTo", 36},
+		{"QQ+, AKs", 22},
+
+		// Duplicates are returned once
+		{"KK+, AA", 12},
+	}
+
+	for _, c := range cases {
+		combos, err := ParseRange(c.notation)
+		assert.Nil(t, err, c.notation)
+		assert.Len(t, combos, c.count, c.notation)
+	}
+}
+
+func TestParseRange_Combos(t *testing.T) {
+
+	combos, err := ParseRange("AA")
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{
+		{"SA", "HA"}, {"SA", "DA"}, {"SA", "CA"},
+		{"HA", "DA"}, {"HA", "CA"},
+		{"DA", "CA"},
+	}, combos)
+
+	combos, err = ParseRange("AKs")
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{{"SA", "SK"}, {"HA", "HK"}, {"DA", "DK"}, {"CA", "CK"}}, combos)
+
+	// Offsuit combos never share a suit
+	combos, err = ParseRange("T9o")
+	assert.Nil(t, err)
+	for _, combo := range combos {
+		assert.NotEqual(t, combo[0][0:1], combo[1][0:1])
+	}
+
+	// Connectors move together
+	combos, err = ParseRange("T9s-87s")
+	assert.Nil(t, err)
+	assert.Contains(t, combos, []string{"ST", "S9"})
+	assert.Contains(t, combos, []string{"H9", "H8"})
+	assert.Contains(t, combos, []string{"D8", "D7"})
+	assert.NotContains(t, combos, []string{"S7", "S6"})
+}
+
+func TestParseRange_Invalid(t *testing.T) {
+
+	for _, notation := range []string{"", "A", "AKx", "QQs", "1K", "AKs-QQ", "AKs-QTs", "AKs-A2o", "A2-A3-A4", "AK,"} {
+		_, err := ParseRange(notation)
+		assert.ErrorIs(t, err, ErrInvalidRange, notation)
+	}
+}
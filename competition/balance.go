@@ -0,0 +1,164 @@
+package competition
+
+import (
+	"sort"
+
+	"github.com/d-protocol/pokerlib/table"
+)
+
+type MoveInstruction struct {
+	PlayerID    string `json:"player_id"`
+	FromTableID string `json:"from_table_id"`
+	ToTableID   string `json:"to_table_id"`
+}
+
+type balancingTable struct {
+	ts      *table.State
+	players []*table.PlayerInfo
+	count   int
+	broken  bool
+}
+
+// Balance computes which players should be moved to equalize the number of players of tables within
+// one. Tables which are no longer needed are broken from the shortest one, and players are moved from
+// the big blind outward.
+func Balance(tables []*table.State) []MoveInstruction {
+
+	moves := make([]MoveInstruction, 0)
+
+	if len(tables) < 2 {
+		return moves
+	}
+
+	bts := make([]*balancingTable, 0, len(tables))
+	total := 0
+	maxSeats := 0
+	for _, ts := range tables {
+
+		bt := &balancingTable{
+			ts:      ts,
+			players: playersFromBigBlind(ts),
+		}
+		bt.count = len(bt.players)
+		bts = append(bts, bt)
+
+		total += bt.count
+
+		if ts.Options != nil && ts.Options.MaxSeats > maxSeats {
+			maxSeats = ts.Options.MaxSeats
+		}
+	}
+
+	if maxSeats == 0 {
+		maxSeats = table.NewOptions().MaxSeats
+	}
+
+	// Break the shortest tables which are no longer needed
+	required := (total + maxSeats - 1) / maxSeats
+	if required == 0 {
+		required = 1
+	}
+
+	shortest := make([]*balancingTable, len(bts))
+	copy(shortest, bts)
+	sort.SliceStable(shortest, func(i, j int) bool {
+		return shortest[i].count < shortest[j].count
+	})
+
+	for _, bt := range shortest[:len(bts)-required] {
+		bt.broken = true
+	}
+
+	for _, bt := range bts {
+
+		if !bt.broken {
+			continue
+		}
+
+		for len(bt.players) > 0 {
+			moves = append(moves, bt.moveTo(smallestTable(bts)))
+		}
+	}
+
+	// Moving players from the biggest table to the smallest one until tables are balanced
+	for {
+		from := biggestTable(bts)
+		to := smallestTable(bts)
+		if from.count-to.count <= 1 {
+			break
+		}
+
+		moves = append(moves, from.moveTo(to))
+	}
+
+	return moves
+}
+
+func (bt *balancingTable) moveTo(to *balancingTable) MoveInstruction {
+
+	p := bt.players[0]
+	bt.players = bt.players[1:]
+	bt.count--
+	to.count++
+
+	return MoveInstruction{
+		PlayerID:    p.ID,
+		FromTableID: bt.ts.ID,
+		ToTableID:   to.ts.ID,
+	}
+}
+
+func smallestTable(bts []*balancingTable) *balancingTable {
+
+	var target *balancingTable
+	for _, bt := range bts {
+		if bt.broken {
+			continue
+		}
+
+		if target == nil || bt.count < target.count {
+			target = bt
+		}
+	}
+
+	return target
+}
+
+func biggestTable(bts []*balancingTable) *balancingTable {
+
+	var target *balancingTable
+	for _, bt := range bts {
+		if bt.broken {
+			continue
+		}
+
+		if target == nil || bt.count > target.count {
+			target = bt
+		}
+	}
+
+	return target
+}
+
+// playersFromBigBlind returns players of table in seat order starting from the big blind.
+func playersFromBigBlind(ts *table.State) []*table.PlayerInfo {
+
+	players := make([]*table.PlayerInfo, 0, len(ts.Players))
+	for _, p := range ts.Players {
+		players = append(players, p)
+	}
+
+	sort.Slice(players, func(i, j int) bool {
+		return players[i].SeatID < players[j].SeatID
+	})
+
+	for i, p := range players {
+		if p.CheckPosition("bb") {
+			ordered := make([]*table.PlayerInfo, 0, len(players))
+			ordered = append(ordered, players[i:]...)
+			return append(ordered, players[:i]...)
+		}
+	}
+
+	return players
+}
@@ -0,0 +1,76 @@
+package competition
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/d-protocol/pokerlib/table"
+	"github.com/stretchr/testify/assert"
+)
+
+func newBalanceTestTable(id string, count int, bbSeat int) *table.State {
+
+	ts := table.NewState()
+	ts.ID = id
+	ts.Options = table.NewOptions()
+
+	for i := 0; i < count; i++ {
+
+		p := &table.PlayerInfo{
+			ID:        fmt.Sprintf("%s_player_%d", id, i),
+			SeatID:    i,
+			Positions: make([]string, 0),
+		}
+
+		if i == bbSeat {
+			p.Positions = append(p.Positions, "bb")
+		}
+
+		ts.Players[i] = p
+	}
+
+	return ts
+}
+
+func Test_Balance_Unbalanced(t *testing.T) {
+
+	tables := []*table.State{
+		newBalanceTestTable("table_1", 9, 2),
+		newBalanceTestTable("table_2", 8, 7),
+		newBalanceTestTable("table_3", 3, 1),
+	}
+
+	moves := Balance(tables)
+	assert.Equal(t, []MoveInstruction{
+		{PlayerID: "table_1_player_2", FromTableID: "table_1", ToTableID: "table_3"},
+		{PlayerID: "table_1_player_3", FromTableID: "table_1", ToTableID: "table_3"},
+		{PlayerID: "table_2_player_7", FromTableID: "table_2", ToTableID: "table_3"},
+	}, moves)
+}
+
+func Test_Balance_BreakTable(t *testing.T) {
+
+	tables := []*table.State{
+		newBalanceTestTable("table_1", 6, 0),
+		newBalanceTestTable("table_2", 2, 1),
+		newBalanceTestTable("table_3", 5, 4),
+	}
+
+	// 13 players need only two tables so the shortest table should be broken
+	moves := Balance(tables)
+	assert.Equal(t, []MoveInstruction{
+		{PlayerID: "table_2_player_1", FromTableID: "table_2", ToTableID: "table_3"},
+		{PlayerID: "table_2_player_0", FromTableID: "table_2", ToTableID: "table_1"},
+	}, moves)
+}
+
+func Test_Balance_Balanced(t *testing.T) {
+
+	tables := []*table.State{
+		newBalanceTestTable("table_1", 7, 0),
+		newBalanceTestTable("table_2", 6, 0),
+		newBalanceTestTable("table_3", 7, 0),
+	}
+
+	assert.Empty(t, Balance(tables))
+}
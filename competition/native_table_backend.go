@@ -3,6 +3,7 @@ package competition
 import (
 	"sync"
 
+	"github.com/d-protocol/pokerlib"
 	"github.com/d-protocol/pokerlib/table"
 )
 
@@ -80,6 +81,23 @@ func (ntb *NativeTableBackend) SetJoinable(tableID string, isJoinable bool) erro
 	return nil
 }
 
+// SetBlinds sets ante and blinds of the table, which take effect from the next hand.
+func (ntb *NativeTableBackend) SetBlinds(tableID string, ante int64, blind pokerlib.BlindSetting) error {
+
+	ntb.mu.RLock()
+	defer ntb.mu.RUnlock()
+
+	t, ok := ntb.tables[tableID]
+	if !ok {
+		return ErrNotFoundTable
+	}
+
+	t.SetAnte(ante)
+	t.SetBlinds(blind.Dealer, blind.SB, blind.BB)
+
+	return nil
+}
+
 func (ntb *NativeTableBackend) ReserveSeat(tableID string, seatID int, p *PlayerInfo) (int, error) {
 
 	ntb.mu.RLock()
@@ -1,6 +1,10 @@
 package competition
 
-import "github.com/d-protocol/pokerlib"
+import (
+	"time"
+
+	"github.com/d-protocol/pokerlib"
+)
 
 type Options struct {
 	GameType              string        `json:"game_type"`
@@ -18,6 +22,16 @@ type TableOptions struct {
 	ActionTime     int                   `json:"action_time"`
 	Ante           int64                 `json:"ante"`
 	Blind          pokerlib.BlindSetting `json:"blind"`
+	BlindStructure []BlindLevel          `json:"blind_structure,omitempty"`
+}
+
+// BlindLevel is a level of blind structure. Duration is in seconds, the last level lasts forever.
+type BlindLevel struct {
+	Level    int   `json:"level"`
+	SB       int64 `json:"sb"`
+	BB       int64 `json:"bb"`
+	Ante     int64 `json:"ante"`
+	Duration int   `json:"duration"`
 }
 
 func NewOptions() *Options {
@@ -41,3 +55,38 @@ func NewOptions() *Options {
 		},
 	}
 }
+
+// GetBlindLevel returns the blind level for the elapsed time since competition started. It returns
+// nil if there is no blind structure.
+func (opts *TableOptions) GetBlindLevel(elapsed time.Duration) *BlindLevel {
+
+	if len(opts.BlindStructure) == 0 {
+		return nil
+	}
+
+	end := time.Duration(0)
+	for i := range opts.BlindStructure {
+		end += time.Duration(opts.BlindStructure[i].Duration) * time.Second
+		if elapsed < end {
+			return &opts.BlindStructure[i]
+		}
+	}
+
+	return &opts.BlindStructure[len(opts.BlindStructure)-1]
+}
+
+// GetBlinds returns ante and blinds for the elapsed time, which fall back to fixed settings if there
+// is no blind structure.
+func (opts *TableOptions) GetBlinds(elapsed time.Duration) (int64, pokerlib.BlindSetting) {
+
+	level := opts.GetBlindLevel(elapsed)
+	if level == nil {
+		return opts.Ante, opts.Blind
+	}
+
+	return level.Ante, pokerlib.BlindSetting{
+		Dealer: opts.Blind.Dealer,
+		SB:     level.SB,
+		BB:     level.BB,
+	}
+}
@@ -0,0 +1,317 @@
+package competition
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/d-protocol/pokerlib"
+	"github.com/d-protocol/pokerlib/table"
+)
+
+var (
+	ErrInsufficientPlayers = errors.New("competition: insufficient number of players")
+	ErrTooManyPlayers      = errors.New("competition: too many players")
+	ErrUnexpectedEvent     = errors.New("competition: unexpected event")
+)
+
+// maxStepsPerGame is used to prevent runner from being stuck in a broken game.
+const maxStepsPerGame = 1000
+
+// Strategy decides action for the current player of game.
+type Strategy func(gs *pokerlib.GameState, playerIdx int) (action string, chips int64)
+
+// BlindSchedule returns ante and blinds for the elapsed time since competition started.
+type BlindSchedule func(elapsed time.Duration) (int64, pokerlib.BlindSetting)
+
+type Ranking struct {
+	Rank     int    `json:"rank"`
+	PlayerID string `json:"player_id"`
+	Bankroll int64  `json:"bankroll"`
+}
+
+type Result struct {
+	Games    int        `json:"games"`
+	Rankings []*Ranking `json:"rankings"`
+}
+
+type runner struct {
+	options  *Options
+	backend  table.Backend
+	strategy Strategy
+	blinds   BlindSchedule
+	now      func() time.Time
+}
+
+type RunOpt func(*runner)
+
+func WithGameBackend(b table.Backend) RunOpt {
+	return func(r *runner) {
+		r.backend = b
+	}
+}
+
+func withClock(now func() time.Time) RunOpt {
+	return func(r *runner) {
+		r.now = now
+	}
+}
+
+func WithStrategy(s Strategy) RunOpt {
+	return func(r *runner) {
+		r.strategy = s
+	}
+}
+
+func WithBlindSchedule(bs BlindSchedule) RunOpt {
+	return func(r *runner) {
+		r.blinds = bs
+	}
+}
+
+// DefaultStrategy goes all-in whenever it is possible, so that competition can be completed quickly.
+func DefaultStrategy(gs *pokerlib.GameState, playerIdx int) (string, int64) {
+
+	p := gs.GetPlayer(playerIdx)
+	if p == nil {
+		return "", 0
+	}
+
+	for _, action := range []string{"allin", "call", "check", "pass", "fold"} {
+		for _, allowed := range p.AllowedActions {
+			if action == allowed {
+				return action, 0
+			}
+		}
+	}
+
+	return "", 0
+}
+
+// Run plays a headless competition on a single table with the table backend until only one player
+// remains or duration of table is reached.
+func Run(opts *Options, players []PlayerInfo, runOpts ...RunOpt) (*Result, error) {
+
+	r := &runner{
+		options:  opts,
+		backend:  table.NewNativeBackend(),
+		strategy: DefaultStrategy,
+		blinds:   opts.Table.GetBlinds,
+		now:      time.Now,
+	}
+
+	for _, opt := range runOpts {
+		opt(r)
+	}
+
+	return r.run(players)
+}
+
+func (r *runner) run(players []PlayerInfo) (*Result, error) {
+
+	if len(players) < 2 || len(players) < r.options.Table.MinPlayers {
+		return nil, ErrInsufficientPlayers
+	}
+
+	if r.options.Table.MaxSeats > 0 && len(players) > r.options.Table.MaxSeats {
+		return nil, ErrTooManyPlayers
+	}
+
+	// Seating players
+	seated := make([]*PlayerInfo, 0, len(players))
+	for i := range players {
+		p := players[i]
+		seated = append(seated, &p)
+	}
+
+	result := &Result{
+		Rankings: make([]*Ranking, len(players)),
+	}
+
+	remaining := len(players)
+	dealerSeat := 0
+	startTime := r.now()
+
+	for {
+
+		alive := make([]*PlayerInfo, 0, len(seated))
+		dealer := 0
+		for seat, p := range seated {
+			if p.Bankroll > 0 {
+				if seat == dealerSeat {
+					dealer = len(alive)
+				}
+				alive = append(alive, p)
+			}
+		}
+
+		if len(alive) < 2 || len(alive) < r.options.Table.MinPlayers {
+			break
+		}
+
+		elapsed := r.now().Sub(startTime)
+		if r.options.Table.Duration > 0 && elapsed >= time.Duration(r.options.Table.Duration)*time.Second {
+			break
+		}
+
+		if result.Games > 0 && r.options.Table.Interval > 0 {
+			time.Sleep(time.Duration(r.options.Table.Interval) * time.Millisecond)
+		}
+
+		ante, blind := r.blinds(elapsed)
+
+		gs, err := r.playGame(alive, dealer, ante, blind)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Games++
+
+		// Updating bankrolls with settlement
+		for _, rs := range gs.Result.Players {
+			alive[rs.Idx].Bankroll = rs.Final
+		}
+
+		// Ranking eliminated players, the one who had more chips before this game is ranked higher
+		eliminated := make([]*pokerlib.PlayerState, 0)
+		for _, p := range gs.Players {
+			if alive[p.Idx].Bankroll == 0 {
+				eliminated = append(eliminated, p)
+			}
+		}
+
+		sort.SliceStable(eliminated, func(i, j int) bool {
+			return eliminated[i].Bankroll < eliminated[j].Bankroll
+		})
+
+		for _, p := range eliminated {
+			result.Rankings[remaining-1] = &Ranking{
+				Rank:     remaining,
+				PlayerID: alive[p.Idx].ID,
+			}
+			remaining--
+		}
+
+		// Move the button to the next seat which has player who is still alive
+		for i := 1; i <= len(seated); i++ {
+			seat := (dealerSeat + i) % len(seated)
+			if seated[seat].Bankroll > 0 {
+				dealerSeat = seat
+				break
+			}
+		}
+	}
+
+	// Ranking players who are still alive by their bankroll
+	alive := make([]*PlayerInfo, 0, remaining)
+	for _, p := range seated {
+		if p.Bankroll > 0 {
+			alive = append(alive, p)
+		}
+	}
+
+	sort.SliceStable(alive, func(i, j int) bool {
+		return alive[i].Bankroll > alive[j].Bankroll
+	})
+
+	for i, p := range alive {
+		result.Rankings[i] = &Ranking{
+			Rank:     i + 1,
+			PlayerID: p.ID,
+			Bankroll: p.Bankroll,
+		}
+	}
+
+	return result, nil
+}
+
+func (r *runner) playGame(players []*PlayerInfo, dealer int, ante int64, blind pokerlib.BlindSetting) (*pokerlib.GameState, error) {
+
+	opts := pokerlib.NewStandardGameOptions()
+
+	switch r.options.GameType {
+	case "short_deck":
+		opts.Deck = pokerlib.NewShortDeckCards()
+	default:
+		opts.Deck = pokerlib.NewStandardDeckCards()
+	}
+
+	opts.Ante = ante
+	opts.Blind = blind
+
+	for _, p := range players {
+		opts.Players = append(opts.Players, &pokerlib.PlayerSetting{
+			PlayerID: p.ID,
+			Bankroll: p.Bankroll,
+		})
+	}
+
+	setupPositions(opts.Players, dealer)
+
+	gs, err := r.backend.CreateGame(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	for step := 0; step < maxStepsPerGame; step++ {
+
+		switch gs.Status.CurrentEvent {
+		case "GameClosed":
+			return gs, nil
+		case "ReadyRequested":
+			gs, err = r.backend.ReadyForAll(gs)
+		case "AnteRequested":
+			gs, err = r.backend.PayAnte(gs)
+		case "BlindsRequested":
+			gs, err = r.backend.PayBlinds(gs)
+		case "RoundStarted":
+			gs, err = r.act(gs)
+		default:
+			return nil, ErrUnexpectedEvent
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, ErrUnexpectedEvent
+}
+
+func setupPositions(players []*pokerlib.PlayerSetting, dealer int) {
+
+	count := len(players)
+
+	// Heads-up: dealer posts small blind
+	if count == 2 {
+		players[dealer].Positions = []string{"dealer", "sb"}
+		players[(dealer+1)%count].Positions = []string{"bb"}
+		return
+	}
+
+	players[dealer].Positions = []string{"dealer"}
+	players[(dealer+1)%count].Positions = []string{"sb"}
+	players[(dealer+2)%count].Positions = []string{"bb"}
+}
+
+func (r *runner) act(gs *pokerlib.GameState) (*pokerlib.GameState, error) {
+
+	action, chips := r.strategy(gs, gs.Status.CurrentPlayer)
+
+	switch action {
+	case "allin":
+		return r.backend.Allin(gs)
+	case "call":
+		return r.backend.Call(gs)
+	case "check":
+		return r.backend.Check(gs)
+	case "pass":
+		return r.backend.Pass(gs)
+	case "bet":
+		return r.backend.Bet(gs, chips)
+	case "raise":
+		return r.backend.Raise(gs, chips)
+	}
+
+	return r.backend.Fold(gs)
+}
@@ -0,0 +1,137 @@
+package competition
+
+import (
+	"testing"
+	"time"
+
+	"github.com/d-protocol/pokerlib"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Run_SitAndGo(t *testing.T) {
+
+	opts := NewOptions()
+	opts.Table.MaxSeats = 3
+
+	players := []PlayerInfo{
+		{ID: "player_1", Bankroll: 1000},
+		{ID: "player_2", Bankroll: 1000},
+		{ID: "player_3", Bankroll: 1000},
+	}
+
+	result, err := Run(opts, players)
+	assert.Nil(t, err)
+	assert.Greater(t, result.Games, 0)
+	assert.Len(t, result.Rankings, 3)
+
+	// Winner takes all chips
+	assert.Equal(t, 1, result.Rankings[0].Rank)
+	assert.Equal(t, int64(3000), result.Rankings[0].Bankroll)
+
+	ids := make(map[string]bool)
+	for i, r := range result.Rankings {
+		assert.Equal(t, i+1, r.Rank)
+		ids[r.PlayerID] = true
+	}
+
+	assert.Len(t, ids, 3)
+
+	// Original player information should not be modified
+	for _, p := range players {
+		assert.Equal(t, int64(1000), p.Bankroll)
+	}
+}
+
+func Test_Run_InsufficientPlayers(t *testing.T) {
+
+	_, err := Run(NewOptions(), []PlayerInfo{
+		{ID: "player_1", Bankroll: 1000},
+	})
+	assert.Equal(t, ErrInsufficientPlayers, err)
+}
+
+func Test_Run_BlindStructure(t *testing.T) {
+
+	opts := NewOptions()
+	opts.Table.BlindStructure = []BlindLevel{
+		{Level: 1, SB: 5, BB: 10, Ante: 0, Duration: 60},
+		{Level: 2, SB: 10, BB: 20, Ante: 5, Duration: 60},
+	}
+
+	// Every game takes 40 seconds in simulated time
+	now := time.Unix(0, 0)
+	clock := func() time.Time {
+		return now
+	}
+
+	blinds := make([]pokerlib.BlindSetting, 0)
+	antes := make([]int64, 0)
+	strategy := func(gs *pokerlib.GameState, playerIdx int) (string, int64) {
+
+		// First decision of a new game
+		if isFirstDecision(gs) {
+			blinds = append(blinds, gs.Meta.Blind)
+			antes = append(antes, gs.Meta.Ante)
+			now = now.Add(40 * time.Second)
+		}
+
+		// Calling station makes sure that game would not be completed quickly
+		p := gs.GetPlayer(playerIdx)
+		for _, action := range []string{"check", "call", "pass", "allin"} {
+			for _, allowed := range p.AllowedActions {
+				if action == allowed {
+					return action, 0
+				}
+			}
+		}
+
+		return "fold", 0
+	}
+
+	r, err := Run(opts, []PlayerInfo{
+		{ID: "player_1", Bankroll: 1000},
+		{ID: "player_2", Bankroll: 1000},
+	}, withClock(clock), WithStrategy(strategy))
+	assert.Nil(t, err)
+	assert.GreaterOrEqual(t, r.Games, 3)
+
+	// Level 1
+	assert.Equal(t, int64(10), blinds[0].BB)
+	assert.Equal(t, int64(0), antes[0])
+	assert.Equal(t, int64(10), blinds[1].BB)
+
+	// Level 2
+	assert.Equal(t, int64(20), blinds[2].BB)
+	assert.Equal(t, int64(10), blinds[2].SB)
+	assert.Equal(t, int64(5), antes[2])
+}
+
+func Test_TableOptions_GetBlindLevel(t *testing.T) {
+
+	opts := NewOptions()
+	assert.Nil(t, opts.Table.GetBlindLevel(0))
+
+	opts.Table.BlindStructure = []BlindLevel{
+		{Level: 1, SB: 5, BB: 10, Duration: 60},
+		{Level: 2, SB: 10, BB: 20, Duration: 60},
+	}
+
+	assert.Equal(t, 1, opts.Table.GetBlindLevel(0).Level)
+	assert.Equal(t, 1, opts.Table.GetBlindLevel(59*time.Second).Level)
+	assert.Equal(t, 2, opts.Table.GetBlindLevel(60*time.Second).Level)
+
+	// The last level lasts forever
+	assert.Equal(t, 2, opts.Table.GetBlindLevel(time.Hour).Level)
+}
+
+func isFirstDecision(gs *pokerlib.GameState) bool {
+
+	for _, a := range gs.Status.ActionHistory {
+		switch a.Type {
+		case "fold", "check", "call", "bet", "raise", "allin", "pass":
+			return false
+		}
+	}
+
+	return true
+}
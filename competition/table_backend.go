@@ -1,6 +1,7 @@
 package competition
 
 import (
+	"github.com/d-protocol/pokerlib"
 	"github.com/d-protocol/pokerlib/table"
 )
 
@@ -15,6 +16,7 @@ type TableBackend interface {
 	CreateTable(opts *table.Options) (*table.State, error)
 	ActivateTable(tableID string) error
 	SetJoinable(tableID string, isJoinable bool) error
+	SetBlinds(tableID string, ante int64, blind pokerlib.BlindSetting) error
 	ReleaseTable(tableID string) error
 	ReserveSeat(tableID string, seatID int, player *PlayerInfo) (int, error)
 	OnTableUpdated(fn func(ts *table.State))
@@ -3,6 +3,7 @@ package competition
 import (
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/d-protocol/pokerlib/match"
 	"github.com/d-protocol/pokerlib/table"
@@ -29,6 +30,7 @@ type tableManager struct {
 	tables              map[string]*table.State
 	count               int64
 	mu                  sync.RWMutex
+	startTime           time.Time
 	onTableStateUpdated func(ts *table.State)
 	onSeatChanged       func(ts *table.State, sc *match.SeatChanges)
 }
@@ -45,6 +47,13 @@ func NewTableManager(options *Options, b TableBackend) TableManager {
 
 func (tm *tableManager) Initialize() error {
 
+	// Blind levels go up by the elapsed time since competition started
+	tm.mu.Lock()
+	tm.startTime = time.Now()
+	tm.mu.Unlock()
+
+	tm.scheduleBlindLevel()
+
 	return nil
 	/*
 	   // Only one static table
@@ -72,10 +81,13 @@ func (tm *tableManager) CreateTable() (*table.State, error) {
 	opts := table.NewOptions()
 	opts.GameType = tm.options.GameType
 	opts.MaxSeats = tm.options.Table.MaxSeats
-	opts.Ante = tm.options.Table.Ante
-	opts.Blind.Dealer = tm.options.Table.Blind.Dealer
-	opts.Blind.SB = tm.options.Table.Blind.SB
-	opts.Blind.BB = tm.options.Table.Blind.BB
+
+	// Tables start with the current blind level
+	ante, blind := tm.options.Table.GetBlinds(tm.elapsed())
+	opts.Ante = ante
+	opts.Blind.Dealer = blind.Dealer
+	opts.Blind.SB = blind.SB
+	opts.Blind.BB = blind.BB
 	opts.EliminateMode = "leave"
 
 	ts, err := tm.b.CreateTable(opts)
@@ -90,6 +102,55 @@ func (tm *tableManager) CreateTable() (*table.State, error) {
 	return ts, nil
 }
 
+// elapsed returns the time since competition started, which is 0 before competition started.
+func (tm *tableManager) elapsed() time.Duration {
+
+	if tm.startTime.IsZero() {
+		return 0
+	}
+
+	return time.Since(tm.startTime)
+}
+
+// scheduleBlindLevel applies blinds to all tables once the current blind level is over.
+func (tm *tableManager) scheduleBlindLevel() {
+
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+
+	elapsed := tm.elapsed()
+
+	// The last level lasts forever
+	levels := tm.options.Table.BlindStructure
+	end := time.Duration(0)
+	for i := 0; i < len(levels)-1; i++ {
+		end += time.Duration(levels[i].Duration) * time.Second
+		if elapsed < end {
+			time.AfterFunc(end-elapsed, tm.updateBlinds)
+			return
+		}
+	}
+}
+
+// updateBlinds applies blinds of the current level to all tables, which take effect from the next hand.
+func (tm *tableManager) updateBlinds() {
+
+	tm.mu.RLock()
+	ante, blind := tm.options.Table.GetBlinds(tm.elapsed())
+	tableIDs := make([]string, 0, len(tm.tables))
+	for id := range tm.tables {
+		tableIDs = append(tableIDs, id)
+	}
+	tm.mu.RUnlock()
+
+	// Tables are not locked by table manager since table updates come back to table manager
+	for _, id := range tableIDs {
+		tm.b.SetBlinds(id, ante, blind)
+	}
+
+	tm.scheduleBlindLevel()
+}
+
 func (tm *tableManager) ReleaseTable(tableID string) error {
 
 	tm.mu.Lock()
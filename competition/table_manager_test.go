@@ -0,0 +1,45 @@
+package competition
+
+import (
+	"testing"
+	"time"
+
+	"github.com/d-protocol/pokerlib"
+	"github.com/d-protocol/pokerlib/table"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_TableManager_BlindLevels(t *testing.T) {
+
+	opts := NewOptions()
+	opts.Table.BlindStructure = []BlindLevel{
+		{Level: 1, SB: 5, BB: 10, Duration: 1},
+		{Level: 2, SB: 10, BB: 20, Ante: 2, Duration: 1},
+	}
+
+	tb := NewNativeTableBackend(table.NewNativeBackend())
+	tm := NewTableManager(opts, tb)
+	assert.Nil(t, tm.Initialize())
+
+	ts, err := tm.CreateTable()
+	assert.Nil(t, err)
+
+	nt := tb.(*NativeTableBackend).GetTable(ts.ID)
+	ante, blind := nt.CurrentBlinds()
+	assert.Equal(t, int64(0), ante)
+	assert.Equal(t, pokerlib.BlindSetting{SB: 5, BB: 10}, blind)
+
+	// Existing tables go up to the next level
+	time.Sleep(1200 * time.Millisecond)
+	ante, blind = nt.CurrentBlinds()
+	assert.Equal(t, int64(2), ante)
+	assert.Equal(t, pokerlib.BlindSetting{SB: 10, BB: 20}, blind)
+
+	// New tables start with the current level
+	ts, err = tm.CreateTable()
+	assert.Nil(t, err)
+
+	ante, blind = tb.(*NativeTableBackend).GetTable(ts.ID).CurrentBlinds()
+	assert.Equal(t, int64(2), ante)
+	assert.Equal(t, pokerlib.BlindSetting{SB: 10, BB: 20}, blind)
+}
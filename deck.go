@@ -179,6 +179,45 @@ func ShuffleCards(cards []string) []string {
 	return result
 }
 
+// CutCards cuts the deck at the specific position, which moves cards above the cut point to the
+// bottom of deck.
+func CutCards(cards []string, position int) []string {
+
+	result := make([]string, 0, len(cards))
+
+	if len(cards) == 0 {
+		return result
+	}
+
+	position = position % len(cards)
+	if position < 0 {
+		position += len(cards)
+	}
+
+	result = append(result, cards[position:]...)
+	result = append(result, cards[:position]...)
+
+	return result
+}
+
+// CutCardsRandom cuts the deck at a cryptographically secure random position.
+func CutCardsRandom(cards []string) []string {
+
+	if len(cards) < 2 {
+		return CutCards(cards, 0)
+	}
+
+	// Cut point should leave at least one card at both parts
+	max := big.NewInt(int64(len(cards) - 1))
+	n, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		source := binary.BigEndian.Uint64(timeBasedSeed())
+		return CutCards(cards, int(source%uint64(len(cards)-1))+1)
+	}
+
+	return CutCards(cards, int(n.Int64())+1)
+}
+
 // timeBasedSeed creates a seed using multiple time sources to increase entropy
 func timeBasedSeed() []byte {
 	now := time.Now()
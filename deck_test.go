@@ -8,6 +8,9 @@ import (
 	"math/big"
 	"strings"
 	"testing"
+
+	"github.com/d-protocol/pokerlib/combination"
+	"github.com/stretchr/testify/assert"
 )
 
 // TestShuffleCardDistribution runs a series of simulations to verify
@@ -342,11 +345,11 @@ func TestCompareShufflingMethods(t *testing.T) {
 		for p := 0; p < playerCount; p++ {
 			// Evaluate original hands
 			origHandTypes[p] = evaluateHand(origPlayerHands[p], origCommunity)
-			origHandStrengths[p] = getHandStrength(origHandTypes[p])
+			origHandStrengths[p] = getHandStrength(combination.CombinationPowerStandard, origHandTypes[p])
 
 			// Evaluate new hands
 			newHandTypes[p] = evaluateHand(newPlayerHands[p], newCommunity)
-			newHandStrengths[p] = getHandStrength(newHandTypes[p])
+			newHandStrengths[p] = getHandStrength(combination.CombinationPowerStandard, newHandTypes[p])
 
 			// Track distribution of hand types
 			origHandTypeDistribution[origHandTypes[p]]++
@@ -588,21 +591,32 @@ func TestCompareShufflingMethods(t *testing.T) {
 	}
 }
 
-// getHandStrength returns a numerical strength for hand comparison
-func getHandStrength(handType string) float64 {
+// handTypeCombinations maps hand types of evaluateHand to combinations
+var handTypeCombinations = map[string]combination.Combination{
+	"High Card":       combination.CombinationHighCard,
+	"Pair":            combination.CombinationPair,
+	"Two Pair":        combination.CombinationTwoPair,
+	"Three of a Kind": combination.CombinationThreeOfAKind,
+	"Straight":        combination.CombinationStraight,
+	"Flush":           combination.CombinationFlush,
+	"Full House":      combination.CombinationFullHouse,
+	"Four of a Kind":  combination.CombinationFourOfAKind,
+	"Straight Flush":  combination.CombinationStraightFlush,
+}
+
+// getHandStrength returns a numerical strength for hand comparison, which follows the category
+// ordering of rankings
+func getHandStrength(pr combination.PowerRankings, handType string) float64 {
 	// Hand type strengths (higher value = stronger hand)
-	handStrengths := map[string]float64{
-		"High Card":       1.0,
-		"Pair":            2.0,
-		"Two Pair":        3.0,
-		"Three of a Kind": 4.0,
-		"Straight":        5.0,
-		"Flush":           6.0,
-		"Full House":      7.0,
-		"Four of a Kind":  8.0,
-		"Straight Flush":  9.0,
-		"Royal Flush":     10.0,
+	handStrengths := make(map[string]float64)
+	for name, c := range handTypeCombinations {
+		for i, ranked := range pr {
+			if ranked == c {
+				handStrengths[name] = float64(i + 1)
+			}
+		}
 	}
+	handStrengths["Royal Flush"] = handStrengths["Straight Flush"] + 1.0
 
 	// Extract base hand type without specifics
 	baseHandType := handType
@@ -644,6 +658,19 @@ func getHandStrength(handType string) float64 {
 	return strength
 }
 
+func TestGetHandStrength_ShortDeck(t *testing.T) {
+
+	// Full house beats flush with standard rankings
+	assert.Greater(t, getHandStrength(combination.CombinationPowerStandard, "Full House"), getHandStrength(combination.CombinationPowerStandard, "Flush"))
+
+	// Flush beats full house with short deck rankings
+	assert.Greater(t, getHandStrength(combination.CombinationPowerShortDeck, "Flush"), getHandStrength(combination.CombinationPowerShortDeck, "Full House"))
+
+	// Trips beat straight with rulesets which rank it higher
+	assert.Greater(t, getHandStrength(combination.CombinationPowerShortDeckTrips, "Three of a Kind"), getHandStrength(combination.CombinationPowerShortDeckTrips, "Straight"))
+	assert.Greater(t, getHandStrength(combination.CombinationPowerShortDeckTrips, "Royal Flush"), getHandStrength(combination.CombinationPowerShortDeckTrips, "Straight Flush"))
+}
+
 // findWinners identifies the indices of players with the highest hand strength
 func findWinners(handStrengths []float64) []int {
 	winners := []int{}
@@ -665,3 +692,299 @@ func findWinners(handStrengths []float64) []int {
 
 	return winners
 }
+
+func TestCutCards(t *testing.T) {
+
+	cards := NewStandardDeckCards()
+
+	cut := CutCards(cards, 10)
+	assert.Equal(t, cards[10], cut[0])
+	assert.Equal(t, cards[9], cut[len(cut)-1])
+	assert.ElementsMatch(t, cards, cut)
+
+	// Original deck is not modified
+	assert.Equal(t, NewStandardDeckCards(), cards)
+
+	// Cut point is normalized
+	assert.Equal(t, cards, CutCards(cards, 0))
+	assert.Equal(t, cards, CutCards(cards, len(cards)))
+	assert.Equal(t, CutCards(cards, len(cards)-1), CutCards(cards, -1))
+	assert.Empty(t, CutCards([]string{}, 3))
+}
+
+func TestCutCardsRandom(t *testing.T) {
+
+	cards := NewStandardDeckCards()
+
+	for i := 0; i < 100; i++ {
+		cut := CutCardsRandom(cards)
+		assert.ElementsMatch(t, cards, cut)
+
+		// Cut is a rotation which is never at the top of deck
+		assert.NotEqual(t, cards[0], cut[0])
+
+		pos := 0
+		for j, c := range cards {
+			if c == cut[0] {
+				pos = j
+			}
+		}
+		assert.Equal(t, CutCards(cards, pos), cut)
+	}
+}
+
+func TestPeekNext(t *testing.T) {
+
+	g := startTestGame(t, newTestGameOptions(10000, 10000, 10000))
+
+	pos := g.GetState().Status.CurrentDeckPosition
+
+	cards, err := g.PeekNext(3)
+	assert.Nil(t, err)
+	assert.Len(t, cards, 3)
+	assert.Equal(t, pos, g.GetState().Status.CurrentDeckPosition)
+	assert.Equal(t, cards, g.Deal(3))
+
+	// Not enough cards left in deck
+	remaining := len(g.GetState().Meta.Deck) - g.GetState().Status.CurrentDeckPosition
+	_, err = g.PeekNext(remaining + 1)
+	assert.ErrorIs(t, err, ErrInsufficientCards)
+
+	cards, err = g.PeekNext(remaining)
+	assert.Nil(t, err)
+	assert.Len(t, cards, remaining)
+}
+
+func TestSetDeckPosition(t *testing.T) {
+
+	g := startTestGame(t, newTestGameOptions(10000, 10000, 10000))
+	assert.Equal(t, g.GetState().Status.CurrentDeckPosition, g.DeckPosition())
+
+	// Next deal draws from the position
+	deck := g.GetState().Meta.Deck
+	assert.Nil(t, g.SetDeckPosition(40))
+	assert.Equal(t, 40, g.DeckPosition())
+	assert.Equal(t, deck[40:43], g.Deal(3))
+	assert.Equal(t, 43, g.DeckPosition())
+
+	// Position beyond deck is rejected
+	assert.ErrorIs(t, g.SetDeckPosition(len(deck)+1), ErrInvalidDeckPosition)
+	assert.ErrorIs(t, g.SetDeckPosition(-1), ErrInvalidDeckPosition)
+	assert.Equal(t, 43, g.DeckPosition())
+
+	assert.Nil(t, g.SetDeckPosition(len(deck)))
+	_, err := g.PeekNext(1)
+	assert.ErrorIs(t, err, ErrInsufficientCards)
+}
+
+func TestNoShuffle(t *testing.T) {
+
+	opts := newTestGameOptions(10000, 10000, 10000)
+	opts.Deck = CutCards(NewStandardDeckCards(), 7)
+	opts.NoShuffle = true
+
+	g := startTestGame(t, opts)
+	gs := g.GetState()
+
+	assert.Equal(t, CutCards(NewStandardDeckCards(), 7), gs.Meta.Deck)
+	assert.Equal(t, opts.Deck[0:2], gs.Players[0].HoleCards)
+	assert.Equal(t, opts.Deck[2:4], gs.Players[1].HoleCards)
+	assert.Equal(t, opts.Deck[4:6], gs.Players[2].HoleCards)
+}
+
+func TestDealOneAtATime(t *testing.T) {
+
+	deck := NewStandardDeckCards()
+
+	opts := newTestGameOptions(10000, 10000, 10000)
+	opts.Deck = deck
+	opts.NoShuffle = true
+	batch := startTestGame(t, opts).GetState()
+
+	opts = newTestGameOptions(10000, 10000, 10000)
+	opts.Deck = deck
+	opts.NoShuffle = true
+	opts.DealOneAtATime = true
+	roundRobin := startTestGame(t, opts).GetState()
+
+	// Each player gets all hole cards at once
+	assert.Equal(t, []string{deck[0], deck[1]}, batch.Players[0].HoleCards)
+	assert.Equal(t, []string{deck[2], deck[3]}, batch.Players[1].HoleCards)
+	assert.Equal(t, []string{deck[4], deck[5]}, batch.Players[2].HoleCards)
+
+	// One card per pass starting from small blind next to dealer
+	assert.Equal(t, []string{deck[2], deck[5]}, roundRobin.Players[0].HoleCards)
+	assert.Equal(t, []string{deck[0], deck[3]}, roundRobin.Players[1].HoleCards)
+	assert.Equal(t, []string{deck[1], deck[4]}, roundRobin.Players[2].HoleCards)
+	assert.Equal(t, batch.Status.CurrentDeckPosition, roundRobin.Status.CurrentDeckPosition)
+
+	// Preset hole cards are placed by dealing order
+	opts = newTestGameOptions(10000, 10000, 10000)
+	opts.DealOneAtATime = true
+	opts.Players[0].PresetHoleCards = []string{"SA", "HA"}
+	opts.Players[2].PresetHoleCards = []string{"CK"}
+	gs := startTestGame(t, opts).GetState()
+
+	assert.Equal(t, []string{"SA", "HA"}, gs.Players[0].HoleCards)
+	assert.Equal(t, "CK", gs.Players[2].HoleCards[0])
+	assert.Len(t, gs.Players[2].HoleCards, 2)
+}
+
+func TestBoardAccessors(t *testing.T) {
+
+	g := startTestGame(t, newTestGameOptions(10000, 10000, 10000))
+
+	// Preflop
+	assert.Empty(t, g.Board())
+	assert.Empty(t, g.Flop())
+	assert.Equal(t, "", g.Turn())
+	assert.Equal(t, "", g.River())
+
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Check())
+
+	checkAll := func() {
+		assert.Nil(t, g.ReadyForAll())
+		assert.Nil(t, g.Check())
+		assert.Nil(t, g.Check())
+		assert.Nil(t, g.Check())
+	}
+
+	// Flop
+	board := g.GetState().Status.Board
+	assert.Len(t, board, 3)
+	assert.Equal(t, board, g.Board())
+	assert.Equal(t, board, g.Flop())
+	assert.Equal(t, "", g.Turn())
+	assert.Equal(t, "", g.River())
+	checkAll()
+
+	// Turn
+	board = g.GetState().Status.Board
+	assert.Len(t, board, 4)
+	assert.Equal(t, board[:3], g.Flop())
+	assert.Equal(t, board[3], g.Turn())
+	assert.Equal(t, "", g.River())
+	checkAll()
+
+	// River
+	board = g.GetState().Status.Board
+	assert.Len(t, board, 5)
+	assert.Equal(t, board, g.Board())
+	assert.Equal(t, board[:3], g.Flop())
+	assert.Equal(t, board[3], g.Turn())
+	assert.Equal(t, board[4], g.River())
+}
+
+func TestBoardLayout(t *testing.T) {
+
+	opts := newTestGameOptions(10000, 10000, 10000)
+	opts.BoardLayout = []int{3, 1, 1, 1}
+
+	g := startTestGame(t, opts)
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Check())
+
+	rounds := make([]string, 0)
+	boardSizes := make([]int, 0)
+	for g.GetEvent() != "GameClosed" {
+		assert.Nil(t, g.ReadyForAll())
+
+		rounds = append(rounds, g.GetState().Status.Round)
+		boardSizes = append(boardSizes, len(g.Board()))
+
+		assert.Nil(t, g.Check())
+		assert.Nil(t, g.Check())
+		assert.Nil(t, g.Check())
+	}
+
+	assert.Equal(t, []string{"flop", "turn", "turn", "river"}, rounds)
+	assert.Equal(t, []int{3, 4, 5, 6}, boardSizes)
+	assert.Len(t, g.Board(), 6)
+	assert.Len(t, g.BurnedCards(), 4)
+	assert.NotNil(t, g.GetState().Result)
+
+	// Accessors follow streets of board layout
+	board := g.Board()
+	assert.Equal(t, board[:3], g.Flop())
+	assert.Equal(t, board[3], g.Turn())
+	assert.Equal(t, board[5], g.River())
+
+	history, err := g.ExportHandHistory()
+	assert.Nil(t, err)
+	assert.Contains(t, history, fmt.Sprintf("*** TURN *** [%s] [%s]\n", handHistoryCards(board[:4]), handHistoryCards(board[4:5])))
+	assert.Contains(t, history, fmt.Sprintf("*** RIVER *** [%s] [%s]\n", handHistoryCards(board[:5]), handHistoryCards(board[5:])))
+
+	// Street has to deal at least one card
+	opts = newTestGameOptions(10000, 10000, 10000)
+	opts.BoardLayout = []int{3, 0}
+	assert.ErrorIs(t, opts.Validate(), ErrInvalidGameConfig)
+	assert.ErrorIs(t, NewGame(opts).Start(), ErrInvalidGameConfig)
+}
+
+func TestBoardLayout_TwoStreets(t *testing.T) {
+
+	opts := newTestGameOptions(10000, 10000, 10000)
+	opts.BoardLayout = []int{3, 2}
+
+	g := startTestGame(t, opts)
+	assert.Nil(t, g.Fold())
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Check())
+	assert.Nil(t, g.ReadyForAll())
+
+	// Flop and river only
+	assert.Len(t, g.Flop(), 3)
+	assert.Empty(t, g.Turn())
+	assert.Empty(t, g.River())
+
+	assert.Nil(t, g.Bet(10))
+	assert.Nil(t, g.Fold())
+
+	assert.True(t, g.IsHandComplete())
+	history, err := g.ExportHandHistory()
+	assert.Nil(t, err)
+	assert.Contains(t, history, "folded on the Flop")
+}
+
+func TestRabbitHunt(t *testing.T) {
+
+	g := startTestGame(t, newTestGameOptions(10000, 10000, 10000))
+	_, err := g.RabbitHunt()
+	assert.ErrorIs(t, err, ErrHandNotComplete)
+
+	// Play the same hand to showdown for comparison
+	c := g.Clone()
+	assert.Nil(t, c.Call())
+	assert.Nil(t, c.Call())
+	assert.Nil(t, c.Check())
+	for c.GetEvent() != "GameClosed" {
+		assert.Nil(t, c.ReadyForAll())
+		assert.Nil(t, c.Check())
+		assert.Nil(t, c.Check())
+		assert.Nil(t, c.Check())
+	}
+
+	_, err = c.RabbitHunt()
+	assert.ErrorIs(t, err, ErrShowdownReached)
+
+	// Everyone folds preflop
+	assert.Nil(t, g.Fold())
+	assert.Nil(t, g.Fold())
+	assert.Equal(t, "GameClosed", g.GetEvent())
+
+	pos := g.GetState().Status.CurrentDeckPosition
+	result := g.GetState().Result
+
+	board, err := g.RabbitHunt()
+	assert.Nil(t, err)
+	assert.Len(t, board, 5)
+	assert.Equal(t, c.Board(), board)
+
+	// Nothing is dealt actually
+	assert.Empty(t, g.Board())
+	assert.Equal(t, pos, g.GetState().Status.CurrentDeckPosition)
+	assert.Equal(t, result, g.GetState().Result)
+}
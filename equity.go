@@ -0,0 +1,104 @@
+package pokerlib
+
+import (
+	"errors"
+	"math/rand"
+	"time"
+)
+
+var (
+	ErrInvalidIterations = errors.New("game: invalid iterations")
+	ErrHoleCardsNotDealt = errors.New("game: hole cards are not dealt")
+)
+
+// LiveEquities returns the chance of winning of each player by seat, which is estimated by dealing
+// the rest of board randomly for iterations. Folded players have no equity, and cards which were
+// dealt already, including hole cards of folded players and burned cards, are never dealt again.
+// Pot is split evenly by players who tie. Board is evaluated once if it is complete.
+func (g *game) LiveEquities(iterations int) ([]float64, error) {
+
+	if iterations <= 0 {
+		return nil, ErrInvalidIterations
+	}
+
+	if len(g.gs.Meta.Deck) == 0 {
+		return nil, ErrNoDeck
+	}
+
+	alive := make([]*PlayerState, 0)
+	for _, p := range g.gs.Players {
+		if p.Fold || p.isDealtOut() {
+			continue
+		}
+
+		if len(p.HoleCards) == 0 {
+			return nil, ErrHoleCardsNotDealt
+		}
+
+		alive = append(alive, p)
+	}
+
+	equities := make([]float64, len(g.gs.Players))
+	if len(alive) == 1 {
+		equities[alive[0].Idx] = 1
+		return equities, nil
+	}
+
+	boardSize := 0
+	for _, count := range g.boardLayout() {
+		boardSize += count
+	}
+
+	// Order of cards which are left in deck is never revealed by sampling them randomly
+	remaining := append([]string{}, g.gs.Meta.Deck[g.gs.Status.CurrentDeckPosition:]...)
+	missing := boardSize - len(g.gs.Status.Board)
+	if missing > len(remaining) {
+		return nil, ErrInsufficientCards
+	}
+
+	if missing <= 0 {
+		iterations = 1
+	}
+
+	rnd := rand.New(rand.NewSource(time.Now().UnixNano()))
+	board := make([]string, 0, boardSize)
+	powers := make([]int, len(alive))
+	for i := 0; i < iterations; i++ {
+
+		// Partial Fisher-Yates for cards of the rest of board
+		for j := 0; j < missing; j++ {
+			k := j + rnd.Intn(len(remaining)-j)
+			remaining[j], remaining[k] = remaining[k], remaining[j]
+		}
+
+		board = append(board[:0], g.gs.Status.Board...)
+		board = append(board, remaining[:missing]...)
+
+		best := 0
+		for j, p := range alive {
+			powers[j] = g.runPower(p, board)
+			if powers[j] > best {
+				best = powers[j]
+			}
+		}
+
+		winners := 0
+		for _, power := range powers {
+			if power == best {
+				winners++
+			}
+		}
+
+		for j, p := range alive {
+			if powers[j] == best {
+				equities[p.Idx] += 1 / float64(winners)
+			}
+		}
+	}
+
+	for i := range equities {
+		equities[i] /= float64(iterations)
+	}
+
+	return equities, nil
+}
@@ -0,0 +1,65 @@
+package pokerlib
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLiveEquities(t *testing.T) {
+
+	opts := newTestGameOptions(10000, 10000, 10000)
+	opts.PresetBoard = []string{"S2", "D7", "C9", "HJ", "D3"}
+	opts.Players[0].PresetHoleCards = []string{"SA", "HA"}
+	opts.Players[1].PresetHoleCards = []string{"SK", "HK"}
+	opts.Players[2].PresetHoleCards = []string{"C4", "H5"}
+
+	g := startTestGame(t, opts)
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Check())
+	assert.Nil(t, g.ReadyForAll())
+
+	// Big blind folds on the flop
+	assert.Nil(t, g.Bet(20))
+	assert.Nil(t, g.Fold())
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.ReadyForAll())
+	assert.Equal(t, "turn", g.GetState().Status.Round)
+
+	_, err := g.LiveEquities(0)
+	assert.ErrorIs(t, err, ErrInvalidIterations)
+
+	// Kings need one of the kings left on the river, unless they were burned
+	equities, err := g.LiveEquities(2000)
+	assert.Nil(t, err)
+	assert.Len(t, equities, 3)
+	assert.InDelta(t, 1.0, equities[0]+equities[1]+equities[2], 0.0001)
+	assert.InDelta(t, 0.97, equities[0], 0.04)
+	assert.Equal(t, 0.0, equities[2])
+
+	// Equities are exact once board is complete
+	playToShowdown(t, g)
+	equities, err = g.LiveEquities(100)
+	assert.Nil(t, err)
+	assert.Equal(t, []float64{1, 0, 0}, equities)
+}
+
+func TestLiveEquities_Tie(t *testing.T) {
+
+	opts := newTestGameOptions(10000, 10000, 10000)
+	opts.PresetBoard = []string{"SA", "SK", "SQ", "SJ", "ST"}
+	opts.Players[0].PresetHoleCards = []string{"H2", "D3"}
+	opts.Players[1].PresetHoleCards = []string{"C2", "H3"}
+	opts.Players[2].PresetHoleCards = []string{"D2", "C3"}
+
+	// Everyone plays the royal flush on board
+	g := startTestGame(t, opts)
+	playToShowdown(t, g)
+
+	equities, err := g.LiveEquities(100)
+	assert.Nil(t, err)
+	for _, e := range equities {
+		assert.InDelta(t, 1.0/3, e, 0.0001)
+	}
+}
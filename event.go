@@ -26,6 +26,11 @@ const (
 	GameEvent_RoundClosed
 
 	// Result
+	//
+	// A hand always ends with the same sequence no matter it reached showdown or not:
+	// RoundClosed -> GameCompleted -> SettlementRequested -> SettlementCompleted -> GameClosed.
+	// Events before GameClosed are emitted internally in a row, so GameClosed is the only terminal
+	// event which can be observed by CurrentEvent once hand has ended.
 	GameEvent_GameCompleted
 	GameEvent_SettlementRequested
 	GameEvent_SettlementCompleted
@@ -193,8 +198,16 @@ func (g *game) onAntePaid() error {
 		return err
 	}
 
-	g.ResetAllPlayerStatus()
-	g.ResetRoundStatus()
+	if g.gs.Meta.LiveAnte {
+		err = g.keepLiveAnte()
+	} else {
+		g.ResetAllPlayerStatus()
+		err = g.ResetRoundStatus()
+	}
+
+	if err != nil {
+		return err
+	}
 
 	return g.EnterPreflopRound()
 }
@@ -274,6 +287,9 @@ func (g *game) onGameCompleted() error {
 
 func (g *game) onSettlementRequested() error {
 
+	// Uncalled bet should not be placed in pots
+	g.returnUncalledBet()
+
 	// Update pots
 	err := g.updatePots()
 	if err != nil {
@@ -293,6 +309,7 @@ func (g *game) onSettlementCompleted() error {
 	return g.EmitEvent(GameEvent_GameClosed)
 }
 
+// onGameClosed is the terminal event, nothing is emitted after it.
 func (g *game) onGameClosed() error {
 	return nil
 }
@@ -0,0 +1,180 @@
+package pokerlib
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Event_TerminalEvent(t *testing.T) {
+
+	// Showdown
+	g := startTestGame(t, newTestGameOptions(10000, 10000, 10000))
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Check())
+
+	for g.GetEvent() != "GameClosed" {
+		assert.Equal(t, "ReadyRequested", g.GetEvent())
+		assert.Nil(t, g.ReadyForAll())
+		assert.Nil(t, g.Check())
+		assert.Nil(t, g.Check())
+		assert.Nil(t, g.Check())
+	}
+
+	assert.Equal(t, "GameClosed", g.GetState().Status.CurrentEvent)
+	assert.NotNil(t, g.GetState().Result)
+
+	// Resuming a closed game does not emit anything else
+	assert.Nil(t, g.Resume())
+	assert.Equal(t, "GameClosed", g.GetState().Status.CurrentEvent)
+
+	// Everyone folded before showdown
+	g = startTestGame(t, newTestGameOptions(10000, 10000, 10000))
+	assert.Nil(t, g.Fold())
+	assert.Nil(t, g.Fold())
+
+	assert.Equal(t, "GameClosed", g.GetState().Status.CurrentEvent)
+	assert.NotNil(t, g.GetState().Result)
+}
+
+func Test_Event_IsRoundClosed(t *testing.T) {
+
+	g := startTestGame(t, newTestGameOptions(10000, 10000, 10000, 10000))
+	assert.False(t, g.IsRoundClosed())
+
+	// Big blind has not acted yet
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Fold())
+	assert.Nil(t, g.Call())
+	assert.False(t, g.IsRoundClosed())
+	assert.False(t, g.IsHandComplete())
+
+	// Flop is waiting for readiness, betting of it is not started
+	assert.Nil(t, g.Check())
+	assert.Equal(t, "flop", g.GetState().Status.Round)
+	assert.False(t, g.IsRoundClosed())
+
+	assert.Nil(t, g.ReadyForAll())
+	assert.Nil(t, g.Check())
+	assert.Nil(t, g.Check())
+	assert.False(t, g.IsRoundClosed())
+
+	// Betting is over although player who folded is still requested to pass
+	assert.Nil(t, g.Check())
+	assert.Equal(t, 0, g.GetCurrentPlayer().SeatIndex())
+	assert.True(t, g.IsRoundClosed())
+	assert.False(t, g.IsHandComplete())
+
+	assert.Nil(t, g.Pass())
+	assert.Equal(t, "turn", g.GetState().Status.Round)
+	assert.False(t, g.IsRoundClosed())
+
+	// Everyone folds to the bet
+	assert.Nil(t, g.ReadyForAll())
+	assert.Nil(t, g.Bet(20))
+	assert.Nil(t, g.Fold())
+	assert.Nil(t, g.Fold())
+	assert.True(t, g.IsHandComplete())
+	assert.True(t, g.IsRoundClosed())
+}
+
+func Test_Event_ActionAfterHandComplete(t *testing.T) {
+
+	g := startTestGame(t, newTestGameOptions(10000, 10000, 10000))
+	assert.Nil(t, g.Fold())
+	assert.Nil(t, g.Fold())
+
+	assert.True(t, g.IsHandComplete())
+	assert.ErrorIs(t, g.Check(), ErrHandComplete)
+	assert.ErrorIs(t, g.Call(), ErrHandComplete)
+	assert.ErrorIs(t, g.RaiseTo(100), ErrHandComplete)
+	assert.ErrorIs(t, g.AutoAct(0), ErrHandComplete)
+	assert.ErrorIs(t, g.ReadyForAll(), ErrHandComplete)
+	assert.Equal(t, "GameClosed", g.GetState().Status.CurrentEvent)
+}
+
+func Test_Event_CombinationUpdated(t *testing.T) {
+
+	opts := newTestGameOptions(10000, 10000, 10000)
+	opts.PresetBoard = []string{"S2", "D7", "C9", "HJ", "CQ"}
+	opts.Players[0].PresetHoleCards = []string{"SA", "HA"}
+	opts.Players[1].PresetHoleCards = []string{"SK", "HK"}
+	opts.Players[2].PresetHoleCards = []string{"C4", "H5"}
+
+	g := NewGame(opts)
+
+	updated := make(map[int][]string)
+	g.OnCombinationUpdated(func(idx int, info *CombinationInfo) {
+		assert.Equal(t, g.GetState().GetPlayer(idx).Combination, info)
+		updated[idx] = info.Cards
+	})
+
+	assert.Nil(t, g.Start())
+	assert.Nil(t, g.ReadyForAll())
+	assert.Nil(t, g.PayBlinds())
+	assert.Nil(t, g.ReadyForAll())
+	assert.Len(t, updated, 3)
+
+	updated = make(map[int][]string)
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Check())
+
+	// Every street improves the best combination of all players
+	for _, round := range []string{"flop", "turn", "river"} {
+		assert.Equal(t, round, g.GetState().Status.Round)
+		assert.Len(t, updated, 3, round)
+
+		for idx, cards := range updated {
+			assert.Equal(t, g.GetState().GetPlayer(idx).Combination.Cards, cards, round)
+		}
+
+		updated = make(map[int][]string)
+		assert.Nil(t, g.ReadyForAll())
+		assert.Nil(t, g.Check())
+		assert.Nil(t, g.Check())
+		assert.Nil(t, g.Check())
+	}
+}
+
+func Test_Event_Deal(t *testing.T) {
+
+	g := NewGame(newTestGameOptions(10000, 10000, 10000))
+
+	targets := make([]string, 0)
+	dealt := make([]string, 0)
+	g.OnDeal(func(target string, cards []string) {
+		targets = append(targets, target)
+		dealt = append(dealt, cards...)
+	})
+
+	assert.Nil(t, g.Start())
+	assert.Nil(t, g.ReadyForAll())
+	assert.Nil(t, g.PayBlinds())
+	assert.Nil(t, g.ReadyForAll())
+
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Check())
+
+	for g.GetEvent() != "GameClosed" {
+		assert.Nil(t, g.ReadyForAll())
+		assert.Nil(t, g.Check())
+		assert.Nil(t, g.Check())
+		assert.Nil(t, g.Check())
+	}
+
+	assert.Equal(t, []string{
+		"hole:0", "hole:1", "hole:2",
+		"burn", "board",
+		"burn", "board",
+		"burn", "board",
+	}, targets)
+
+	// Cards are dealt from deck in order
+	gs := g.GetState()
+	assert.Equal(t, gs.Meta.Deck[:gs.Status.CurrentDeckPosition], dealt)
+	assert.Equal(t, gs.GetPlayer(1).HoleCards, dealt[2:4])
+	assert.Equal(t, gs.Status.Board[:3], dealt[7:10])
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/d-protocol/pokerlib/pot"
@@ -14,26 +15,54 @@ var (
 	ErrNotEnoughBackroll           = errors.New("game: backroll is not enough")
 	ErrNoDealer                    = errors.New("game: no dealer")
 	ErrInsufficientNumberOfPlayers = errors.New("game: insufficient number of players")
+	ErrTooManyPlayers              = errors.New("game: too many players")
 	ErrUnknownRound                = errors.New("game: unknown round")
 	ErrNotFoundDealer              = errors.New("game: not found dealer")
 	ErrUnknownTask                 = errors.New("game: unknown task")
 	ErrNotClosedRound              = errors.New("game: round is not closed")
+	ErrNotCurrentPlayer            = errors.New("game: not current player")
+	ErrNotFoundPlayer              = errors.New("game: not found player")
+	ErrInsufficientCards           = errors.New("game: insufficient cards")
+	ErrHandComplete                = errors.New("game: hand is complete")
+	ErrHandNotComplete             = errors.New("game: hand is not complete")
+	ErrShowdownReached             = errors.New("game: hand reached showdown")
+	ErrInvalidDeckPosition         = errors.New("game: invalid deck position")
+	ErrInvalidCardIndex            = errors.New("game: invalid card index")
+	ErrInvalidTimeExtension        = errors.New("game: invalid time extension")
+	ErrLastAlivePlayer             = errors.New("game: player is the last one alive")
 )
 
+// Game is not safe for concurrent use. All methods, including getters, must be called from a
+// single goroutine at a time; use NewSyncGame to share a game between goroutines.
 type Game interface {
 	ApplyOptions(opts *GameOptions) error
 	Start() error
 	Resume() error
 	GetEvent() string
 	GetState() *GameState
+	Clone() Game
 	GetStateJSON() ([]byte, error)
+	GetPublicStateJSON(forSeat int) ([]byte, error)
+	Summary() StateSummary
 	LoadState(gs *GameState) error
+	NewHand(deck []string, positions map[int][]string) error
 	Player(idx int) Player
 	Dealer() Player
 	SmallBlind() Player
 	BigBlind() Player
 	Deal(count int) []string
+	PeekNext(count int) ([]string, error)
+	DeckPosition() int
+	SetDeckPosition(pos int) error
 	Burn(count int) error
+	BurnedCards() []string
+	Board() []string
+	Flop() []string
+	Turn() string
+	River() string
+	RabbitHunt() ([]string, error)
+	ShowCard(idx int, cardIndex int) error
+	ExtendPlayerTime(idx int, ms int64) error
 	BecomeRaiser(Player) error
 	ResetActedPlayers() error
 	ResetAllPlayerStatus() error
@@ -42,15 +71,42 @@ type Game interface {
 	GetPlayers() []Player
 	SetCurrentPlayer(Player) error
 	GetCurrentPlayer() Player
+	IsHandComplete() bool
+	IsRoundClosed() bool
 	GetAllowedActions(Player) []string
 	GetAvailableActions(Player) []string
+	PreviewActions(idx int) []string
+	CallAmount(idx int) int64
+	IsCallAllin(idx int) bool
+	HandActionCounts(idx int) ActionCounts
+	MinBet() int64
+	MinRaise() int64
 	GetAlivePlayerCount() int
 	GetMovablePlayerCount() int
+	StackRanking() []int
+	ChipLeader() int
 	UpdateLastAction(source int, ptype string, value int64) error
 	EmitEvent(event GameEvent) error
+	ExportHandHistory() (string, error)
 	PrintState() error
+	Pots() []PotView
+	AllInPlayers() []AllInInfo
+	LiveEquities(iterations int) ([]float64, error)
+	CashOut(idx int, fraction float64) (int64, error)
+	PotAfterRake() int64
+	IsChopped(potIdx int) bool
+	KillPotWinner() int
+	SeatsFromButton() []int
 	PrintPots()
 
+	// OnCombinationUpdated registers a callback which is called for each player whose best
+	// combination changed after a round is initialized
+	OnCombinationUpdated(fn func(idx int, info *CombinationInfo))
+
+	// OnDeal registers a callback which is called for cards dealt to target, which is "hole:<seat>",
+	// "board", "burn" or "run:<n>" for board of an additional run
+	OnDeal(fn func(target string, cards []string))
+
 	// Operations
 	Next() error
 	ReadyForAll() error
@@ -65,7 +121,21 @@ type Game interface {
 	Call() error
 	Allin() error
 	Bet(chips int64) error
+	Complete() error
+
+	// Raise raises the total wager of current player in this round to chipLevel (raise-to)
 	Raise(chipLevel int64) error
+	RaiseTo(total int64) error
+	RaiseBy(amount int64) error
+
+	// AutoAct applies the default action for the current player whose time is up
+	AutoAct(idx int) error
+
+	// ForceFold folds the player immediately and makes player sit out for subsequent hands
+	ForceFold(idx int) error
+
+	// MarkDeadHand kills the hand of player who is not able to win the pot anymore
+	MarkDeadHand(idx int) error
 }
 
 type game struct {
@@ -74,11 +144,31 @@ type game struct {
 	dealer     Player
 	smallBlind Player
 	bigBlind   Player
+	forcedBet  func(gs *GameState) (int, int64)
+	noShuffle  bool
+	shuffler   Shuffler
+	cut        bool
+
+	presetBoard     []string
+	presetHoleCards map[int][]string
+
+	// Blinds of options, stakes of each hand are based on them
+	blind BlindSetting
+
+	// Options were invalid, so that game is not able to start
+	optionsErr error
+
+	onCombinationUpdated func(idx int, info *CombinationInfo)
+	onDeal               func(target string, cards []string)
 }
 
+// NewGame creates a game with options, invalid options are reported by Start.
 func NewGame(opts *GameOptions) *game {
 	g := &game{
 		players: make(map[int]Player),
+		gs: &GameState{
+			Players: make([]*PlayerState, 0),
+		},
 	}
 	g.ApplyOptions(opts)
 	return g
@@ -101,6 +191,29 @@ func (g *game) GetState() *GameState {
 	return g.gs
 }
 
+// Clone returns an independent game with a deep copy of state, which is useful to evaluate actions
+// speculatively without affecting the original game.
+func (g *game) Clone() Game {
+
+	c := NewGameFromState(g.gs.Clone())
+	c.forcedBet = g.forcedBet
+	c.blind = g.blind
+	c.optionsErr = g.optionsErr
+	c.noShuffle = g.noShuffle
+	c.shuffler = g.shuffler
+	c.cut = g.cut
+	c.presetBoard = append([]string{}, g.presetBoard...)
+
+	if g.presetHoleCards != nil {
+		c.presetHoleCards = make(map[int][]string, len(g.presetHoleCards))
+		for idx, cards := range g.presetHoleCards {
+			c.presetHoleCards[idx] = append([]string{}, cards...)
+		}
+	}
+
+	return c
+}
+
 func (g *game) GetStateJSON() ([]byte, error) {
 	return json.Marshal(g.gs)
 }
@@ -133,23 +246,181 @@ func (g *game) Resume() error {
 
 func (g *game) ApplyOptions(opts *GameOptions) error {
 
+	// Hole cards settings are filled by game type if they are not specified
+	g.optionsErr = opts.Validate()
+	if g.optionsErr != nil {
+		return g.optionsErr
+	}
+
+	boardLayout := make([]int, len(opts.BoardLayout))
+	copy(boardLayout, opts.BoardLayout)
+
+	var disabledActions map[string][]string
+	if len(opts.DisabledActions) > 0 {
+		disabledActions = make(map[string][]string, len(opts.DisabledActions))
+		for round, actions := range opts.DisabledActions {
+			disabledActions[round] = append([]string{}, actions...)
+		}
+	}
+
+	killed := false
+	if opts.KillPot.Threshold > 0 {
+		for _, p := range opts.Players {
+			if p.Killed && !p.Empty {
+				killed = true
+				break
+			}
+		}
+	}
+
+	g.blind = opts.Blind
+	blind := handBlind(opts.Blind, killed, opts.MinChipUnit)
+
 	g.gs = &GameState{
 		Players: make([]*PlayerState, 0),
 		Meta: Meta{
+			GameType:               opts.GameType,
 			Ante:                   opts.Ante,
-			Blind:                  opts.Blind,
+			LiveAnte:               opts.LiveAnte,
+			Blind:                  blind,
 			Limit:                  opts.Limit,
+			LimitSetting:           opts.LimitSetting,
 			HoleCardsCount:         opts.HoleCardsCount,
 			RequiredHoleCardsCount: opts.RequiredHoleCardsCount,
 			CombinationPowers:      opts.CombinationPowers,
 			Deck:                   opts.Deck,
 			BurnCount:              opts.BurnCount,
+			DealOneAtATime:         opts.DealOneAtATime,
+			RunCount:               opts.RunCount,
+			MaxRaisesPerStreet:     opts.MaxRaisesPerStreet,
+			MinChipUnit:            opts.MinChipUnit,
+			BoardLayout:            boardLayout,
+			DisabledActions:        disabledActions,
+			FirstToActRule:         opts.FirstToActRule,
+			MaxSeats:               opts.MaxSeats,
+			ShuffleSeed:            opts.ShuffleSeed,
+			KillPot:                opts.KillPot,
+			Rake:                   opts.Rake,
 		},
 	}
 
+	g.forcedBet = opts.ForcedBet
+	g.noShuffle = opts.NoShuffle
+	g.cut = opts.Cut
+	g.shuffler = opts.Shuffler
+	g.presetBoard = opts.PresetBoard
+
 	// Loading players
 	for idx, p := range opts.Players {
 		g.AddPlayer(idx, p)
+
+		if len(p.PresetHoleCards) > 0 {
+			if g.presetHoleCards == nil {
+				g.presetHoleCards = make(map[int][]string)
+			}
+
+			g.presetHoleCards[idx] = p.PresetHoleCards
+		}
+	}
+
+	return nil
+}
+
+// handBlind returns blinds of a hand, stakes double if pot was killed in the last hand.
+func handBlind(blind BlindSetting, killed bool, unit int64) BlindSetting {
+
+	if killed {
+		blind.Dealer *= 2
+		blind.SB *= 2
+		blind.BB *= 2
+	}
+
+	// Small blind is posted in multiples of the minimum chip unit by house rule
+	blind.SB = roundSmallBlind(blind, unit)
+
+	return blind
+}
+
+// NewHand resets the game for the next hand with deck and positions of players by seat, so that a game
+// can be reused across hands, and then it has to be started again. Bankrolls are carried over from
+// result of the last hand, and seats and settings are kept except shuffle seed and preset cards which
+// are only for one hand. The last deck is used if deck is empty.
+func (g *game) NewHand(deck []string, positions map[int][]string) error {
+
+	// Hand is in progress
+	if len(g.gs.Status.CurrentEvent) > 0 && !g.IsHandComplete() {
+		return ErrHandNotComplete
+	}
+
+	seatPositions := make([][]string, 0, len(g.gs.Players))
+	for _, p := range g.gs.Players {
+		if !p.Empty {
+			seatPositions = append(seatPositions, positions[p.Idx])
+		}
+	}
+
+	err := validatePositions(seatPositions)
+	if err != nil {
+		return err
+	}
+
+	bankrolls := make(map[int]int64, len(g.gs.Players))
+	for _, p := range g.gs.Players {
+		bankrolls[p.Idx] = p.Bankroll
+	}
+
+	if g.gs.Result != nil {
+		for _, r := range g.gs.Result.Players {
+			bankrolls[r.Idx] = r.Final
+		}
+	}
+
+	meta := g.gs.Meta
+	if len(deck) > 0 {
+		meta.Deck = append([]string{}, deck...)
+	}
+
+	meta.ShuffleSeed = 0
+	meta.DeckFingerprint = ""
+
+	// Stakes of the last hand might be doubled by kill pot, game loaded from state has no blinds of
+	// options so that blinds of the last hand are kept as-is
+	killer := g.KillPotWinner()
+	if g.blind.BB > 0 {
+		meta.Blind = handBlind(g.blind, killer >= 0, meta.MinChipUnit)
+	}
+
+	players := g.gs.Players
+
+	g.gs = &GameState{
+		GameID:  g.gs.GameID,
+		Meta:    meta,
+		Players: make([]*PlayerState, 0, len(players)),
+	}
+
+	g.players = make(map[int]Player)
+	g.dealer = nil
+	g.smallBlind = nil
+	g.bigBlind = nil
+	g.presetBoard = nil
+	g.presetHoleCards = nil
+
+	for _, p := range players {
+		g.AddPlayer(p.Idx, &PlayerSetting{
+			PlayerID:  p.PlayerID,
+			Bankroll:  bankrolls[p.Idx],
+			Positions: append([]string{}, positions[p.Idx]...),
+			SitOut:    p.SitOut,
+			Empty:     p.Empty,
+			Killed:    p.Idx == killer,
+
+			// Player who is still sitting out posts when returning
+			MustPostBlind: p.MustPostBlind,
+			MissedBlind:   p.MissedBlind,
+
+			// Time bank is kept across hands
+			TimeBank: p.TimeBank,
+		})
 	}
 
 	return nil
@@ -184,13 +455,33 @@ func (g *game) AddPlayer(idx int, setting *PlayerSetting) error {
 	// Create player state
 	ps := &PlayerState{
 		Idx:              idx,
+		PlayerID:         setting.PlayerID,
 		Positions:        setting.Positions,
 		Bankroll:         setting.Bankroll,
 		InitialStackSize: setting.Bankroll,
 		StackSize:        setting.Bankroll,
+		SitOut:           setting.SitOut,
+		Killed:           setting.Killed,
+		MustPostBlind:    setting.MustPostBlind,
+		MissedBlind:      setting.MissedBlind,
+		TimeBank:         setting.TimeBank,
 		Combination:      &CombinationInfo{},
 	}
 
+	// Empty seat is treated as a player who folded already
+	if setting.Empty {
+		ps.Empty = true
+		ps.Killed = false
+		ps.MustPostBlind = false
+		ps.MissedBlind = 0
+		ps.Fold = true
+		ps.Positions = []string{}
+		ps.Bankroll = 0
+		ps.InitialStackSize = 0
+		ps.StackSize = 0
+		ps.Combination = nil
+	}
+
 	g.gs.Players = append(g.gs.Players, ps)
 
 	return g.addPlayer(ps)
@@ -230,11 +521,245 @@ func (g *game) Deal(count int) []string {
 	return cards
 }
 
+// PeekNext returns the next cards of deck without dealing them.
+func (g *game) PeekNext(count int) ([]string, error) {
+
+	pos := g.gs.Status.CurrentDeckPosition
+	if count < 0 || pos+count > len(g.gs.Meta.Deck) {
+		return nil, ErrInsufficientCards
+	}
+
+	cards := make([]string, count)
+	copy(cards, g.gs.Meta.Deck[pos:pos+count])
+
+	return cards, nil
+}
+
+// DeckPosition returns the position of the next card to be dealt from deck.
+func (g *game) DeckPosition() int {
+	return g.gs.Status.CurrentDeckPosition
+}
+
+// SetDeckPosition moves the position of the next card to be dealt, which is for custom dealing.
+// Position at the end of deck is allowed, nothing can be dealt from there.
+func (g *game) SetDeckPosition(pos int) error {
+
+	if pos < 0 || pos > len(g.gs.Meta.Deck) {
+		return ErrInvalidDeckPosition
+	}
+
+	g.gs.Status.CurrentDeckPosition = pos
+
+	return nil
+}
+
+// OnDeal registers a callback to notify clients of every card dealt in the hand, e.g., for overlays
+// of live streams. Callback is invoked synchronously, so it must not call back into the game.
+func (g *game) OnDeal(fn func(target string, cards []string)) {
+	g.onDeal = fn
+}
+
+// dealTo deals cards for target and notifies clients.
+func (g *game) dealTo(target string, count int) []string {
+
+	cards := g.Deal(count)
+
+	if g.onDeal != nil {
+		g.onDeal(target, append([]string{}, cards...))
+	}
+
+	return cards
+}
+
+// holeCardsDealingOrder returns seats of players in the order which hole cards are dealt to, one
+// entry for each card. Cards are dealt to players in order of seats in a batch by default, or one at
+// a time round-robin starting from the player next to dealer like a real dealer does.
+func (g *game) holeCardsDealingOrder() []int {
+
+	players := make([]int, 0, len(g.gs.Players))
+	for _, p := range g.gs.Players {
+		if !p.isDealtOut() {
+			players = append(players, p.Idx)
+		}
+	}
+
+	order := make([]int, 0, len(players)*g.gs.Meta.HoleCardsCount)
+
+	if !g.gs.Meta.DealOneAtATime {
+		for _, idx := range players {
+			for i := 0; i < g.gs.Meta.HoleCardsCount; i++ {
+				order = append(order, idx)
+			}
+		}
+
+		return order
+	}
+
+	// Rotate players to start from the one next to dealer
+	start := 0
+	if dealer := g.Dealer(); dealer != nil {
+		for i, idx := range players {
+			if idx > dealer.SeatIndex() {
+				start = i
+				break
+			}
+		}
+	}
+
+	for i := 0; i < g.gs.Meta.HoleCardsCount; i++ {
+		for j := range players {
+			order = append(order, players[(start+j)%len(players)])
+		}
+	}
+
+	return order
+}
+
 func (g *game) Burn(count int) error {
-	g.gs.Status.Burned = append(g.gs.Status.Burned, g.Deal(count)...)
+	g.gs.Status.Burned = append(g.gs.Status.Burned, g.dealTo("burn", count)...)
+	return nil
+}
+
+// BurnedCards returns cards which were burned in order.
+func (g *game) BurnedCards() []string {
+	cards := make([]string, len(g.gs.Status.Burned))
+	copy(cards, g.gs.Status.Burned)
+	return cards
+}
+
+// Board returns all board cards which were dealt.
+func (g *game) Board() []string {
+	cards := make([]string, len(g.gs.Status.Board))
+	copy(cards, g.gs.Status.Board)
+	return cards
+}
+
+// Flop returns cards of flop, or an empty slice before flop is dealt.
+func (g *game) Flop() []string {
+
+	flop := g.gs.streetCards(0)
+
+	cards := make([]string, len(flop))
+	copy(cards, flop)
+
+	return cards
+}
+
+// Turn returns the turn card, or an empty string before turn is dealt. The first card is returned if
+// turn of board layout has multiple cards, and there is no turn if board has less than three streets.
+func (g *game) Turn() string {
+
+	if len(g.boardLayout()) < 3 {
+		return ""
+	}
+
+	cards := g.gs.streetCards(1)
+	if len(cards) == 0 {
+		return ""
+	}
+
+	return cards[0]
+}
+
+// River returns the river card which is the last street of board, or an empty string before river
+// is dealt. The first card is returned if river of board layout has multiple cards.
+func (g *game) River() string {
+
+	layout := g.boardLayout()
+	if len(layout) < 2 {
+		return ""
+	}
+
+	cards := g.gs.streetCards(len(layout) - 1)
+	if len(cards) == 0 {
+		return ""
+	}
+
+	return cards[0]
+}
+
+// RabbitHunt returns board cards which would have been dealt if everyone had not folded. Deck and
+// result of the hand are not affected.
+func (g *game) RabbitHunt() ([]string, error) {
+
+	if !g.IsHandComplete() {
+		return nil, ErrHandNotComplete
+	}
+
+	if g.GetAlivePlayerCount() > 1 {
+		return nil, ErrShowdownReached
+	}
+
+	cards := make([]string, 0)
+	pos := g.gs.Status.CurrentDeckPosition
+	for _, count := range g.boardLayout()[g.dealtStreetCount():] {
+
+		// Burn
+		pos++
+
+		if pos+count > len(g.gs.Meta.Deck) {
+			return nil, ErrInsufficientCards
+		}
+
+		cards = append(cards, g.gs.Meta.Deck[pos:pos+count]...)
+		pos += count
+	}
+
+	return cards, nil
+}
+
+// ShowCard reveals a single hole card of the player to others after the hand is complete, while
+// the rest of hole cards stay hidden.
+func (g *game) ShowCard(idx int, cardIndex int) error {
+
+	if !g.IsHandComplete() {
+		return ErrHandNotComplete
+	}
+
+	p := g.Player(idx)
+	if p == nil {
+		return ErrNotFoundPlayer
+	}
+
+	ps := p.State()
+	if cardIndex < 0 || cardIndex >= len(ps.HoleCards) {
+		return ErrInvalidCardIndex
+	}
+
+	for _, i := range ps.ShownCards {
+		if i == cardIndex {
+			return nil
+		}
+	}
+
+	ps.ShownCards = append(ps.ShownCards, cardIndex)
+	sort.Ints(ps.ShownCards)
+
 	return nil
 }
 
+// ExtendPlayerTime adds time in milliseconds to the time bank of player, which is recorded as an
+// action so that clients are able to show the extension.
+func (g *game) ExtendPlayerTime(idx int, ms int64) error {
+
+	if g.IsHandComplete() {
+		return ErrHandComplete
+	}
+
+	if ms <= 0 {
+		return ErrInvalidTimeExtension
+	}
+
+	p := g.Player(idx)
+	if p == nil {
+		return ErrNotFoundPlayer
+	}
+
+	p.State().TimeBank += ms
+
+	return g.UpdateLastAction(idx, "extend_time", ms)
+}
+
 func (g *game) ResetAllPlayerAllowedActions() error {
 	for _, p := range g.GetPlayers() {
 		p.Reset()
@@ -251,7 +776,9 @@ func (g *game) ResetAllPlayerStatus() error {
 		ps.Wager = 0
 		ps.InitialStackSize = ps.StackSize
 
-		if ps.Fold {
+		if ps.isDealtOut() {
+			ps.DidAction = ""
+		} else if ps.Fold {
 			ps.DidAction = "fold"
 		} else if ps.InitialStackSize == 0 {
 			ps.DidAction = "allin"
@@ -265,10 +792,17 @@ func (g *game) ResetAllPlayerStatus() error {
 
 func (g *game) ResetRoundStatus() error {
 	g.gs.Status.PreviousRaiseSize = 0
+	g.gs.Status.RaiseCount = 0
 	g.gs.Status.MaxWager = 0
 	g.gs.Status.CurrentRoundPot = 0
 	g.gs.Status.CurrentWager = 0
-	g.gs.Status.CurrentRaiser = g.Dealer().State().Idx
+
+	dealer := g.Dealer()
+	if dealer == nil {
+		return ErrNotFoundDealer
+	}
+
+	g.gs.Status.CurrentRaiser = dealer.SeatIndex()
 	g.gs.Status.CurrentPlayer = g.gs.Status.CurrentRaiser
 	return nil
 }
@@ -294,11 +828,74 @@ func (g *game) GetCurrentPlayer() Player {
 	return g.Player(g.gs.Status.CurrentPlayer)
 }
 
+// IsHandComplete returns true if hand has reached the end, after which no action can be taken.
+func (g *game) IsHandComplete() bool {
+	event, ok := GameEventBySymbol[g.gs.Status.CurrentEvent]
+	return ok && event >= GameEvent_GameCompleted
+}
+
+// IsRoundClosed returns true if betting of the current round is over, that is, every player who is
+// able to bet has acted and matched the current wager, or only one player is left. Players who
+// folded or went all-in might still be requested to pass. It returns false before betting of the
+// round started.
+func (g *game) IsRoundClosed() bool {
+
+	if g.IsHandComplete() {
+		return true
+	}
+
+	switch g.gs.Status.CurrentEvent {
+	case "RoundClosed":
+		return true
+	case "RoundStarted":
+	default:
+		return false
+	}
+
+	if g.GetAlivePlayerCount() <= 1 {
+		return true
+	}
+
+	for _, ps := range g.gs.Players {
+
+		// Players who are not able to bet
+		if ps.isDealtOut() || ps.Fold || ps.StackSize == 0 {
+			continue
+		}
+
+		if !ps.Acted || ps.Wager < g.gs.Status.CurrentWager {
+			return false
+		}
+	}
+
+	return true
+}
+
+// actor returns current player who is able to take action.
+func (g *game) actor() (Player, error) {
+
+	if g.IsHandComplete() {
+		return nil, ErrHandComplete
+	}
+
+	p := g.GetCurrentPlayer()
+	if p == nil {
+		return nil, ErrNotCurrentPlayer
+	}
+
+	return p, nil
+}
+
 func (g *game) NextPlayer() Player {
 
 	cur := g.gs.Status.CurrentPlayer
 	playerCount := g.GetPlayerCount()
 
+	// No current player to move from
+	if cur < 0 || cur >= playerCount {
+		return nil
+	}
+
 	for i := 1; i < playerCount; i++ {
 
 		// Find the next player
@@ -311,6 +908,11 @@ func (g *game) NextPlayer() Player {
 
 		p := g.gs.Players[cur]
 
+		// Skip seat without player or player who has no chips
+		if p.isDealtOut() {
+			continue
+		}
+
 		return g.Player(p.Idx)
 	}
 
@@ -321,13 +923,28 @@ func (g *game) GetPlayerCount() int {
 	return len(g.gs.Players)
 }
 
+func (g *game) getDealtInPlayerCount() int {
+
+	count := 0
+	for _, p := range g.gs.Players {
+		if !p.isDealtOut() {
+			count++
+		}
+	}
+
+	return count
+}
+
 func (g *game) GetPlayers() []Player {
 
 	players := make([]Player, 0)
 	playerCount := g.GetPlayerCount()
 
 	// Getting player list that dealer should be the first element of it
-	cur := g.Dealer().SeatIndex()
+	cur := 0
+	if dealer := g.Dealer(); dealer != nil {
+		cur = dealer.SeatIndex()
+	}
 
 	for i := 0; i < playerCount; i++ {
 
@@ -361,9 +978,9 @@ func (g *game) setCurrentPlayer(p Player) error {
 
 func (g *game) SetCurrentPlayer(p Player) error {
 
-	if g.gs.Status.CurrentPlayer != -1 {
-		// Clear allowed actions of current player
-		g.GetCurrentPlayer().ResetAllowedActions()
+	// Clear allowed actions of current player
+	if cp := g.GetCurrentPlayer(); cp != nil {
+		cp.ResetAllowedActions()
 	}
 
 	err := g.setCurrentPlayer(p)
@@ -407,16 +1024,61 @@ func (g *game) GetMovablePlayerCount() int {
 	return mCount
 }
 
+// StackRanking returns seat indices of players sorted by current stack in descending order. Ties
+// are broken by seat index and empty seats are excluded.
+func (g *game) StackRanking() []int {
+
+	players := make([]*PlayerState, 0, len(g.gs.Players))
+	for _, p := range g.gs.Players {
+		if !p.Empty {
+			players = append(players, p)
+		}
+	}
+
+	sort.SliceStable(players, func(i, j int) bool {
+		if players[i].StackSize != players[j].StackSize {
+			return players[i].StackSize > players[j].StackSize
+		}
+
+		return players[i].Idx < players[j].Idx
+	})
+
+	seats := make([]int, len(players))
+	for i, p := range players {
+		seats[i] = p.Idx
+	}
+
+	return seats
+}
+
+// ChipLeader returns seat index of player with the largest stack, or -1 if there is no player.
+func (g *game) ChipLeader() int {
+
+	ranking := g.StackRanking()
+	if len(ranking) == 0 {
+		return -1
+	}
+
+	return ranking[0]
+}
+
 func (g *game) BecomeRaiser(p Player) error {
 
+	if p == nil {
+		return ErrNotFoundPlayer
+	}
+
 	if p.State().Wager > 0 {
 		p.State().VPIP = true
 	}
 
 	g.gs.Status.CurrentRaiser = p.SeatIndex()
 
-	// Reset all player states except raiser
+	// Reset all player states except raiser, full raise reopens raising for everyone
 	g.ResetActedPlayers()
+	for _, ps := range g.gs.Players {
+		ps.RaiseClosed = false
+	}
 	p.State().Acted = true
 
 	return nil
@@ -432,8 +1094,8 @@ func (g *game) ResetActedPlayers() error {
 
 func (g *game) RequestPlayerAction() error {
 
-	// only one player left
-	if g.GetAlivePlayerCount() == 1 {
+	// only one player left, the pot goes to the player without any action
+	if g.GetAlivePlayerCount() <= 1 {
 		return g.EmitEvent(GameEvent_RoundClosed)
 	}
 
@@ -444,17 +1106,66 @@ func (g *game) RequestPlayerAction() error {
 
 	// next player
 	p := g.NextPlayer()
+	if p == nil {
+		return ErrNotCurrentPlayer
+	}
 
 	// Run around already, no one need to act
 	if p.State().Acted {
 		return g.EmitEvent(GameEvent_RoundClosed)
 	}
 
+	// Player who is sitting out should never be current player
+	if g.isSittingOut(p) {
+		g.autoAct(p)
+		return g.RequestPlayerAction()
+	}
+
 	return g.SetCurrentPlayer(p)
 }
 
+func (g *game) isSittingOut(p Player) bool {
+	ps := p.State()
+	return ps.SitOut && !ps.Fold && ps.StackSize > 0
+}
+
+func (g *game) autoAct(p Player) {
+
+	// Clear allowed actions of current player
+	if cp := g.GetCurrentPlayer(); cp != nil {
+		cp.ResetAllowedActions()
+	}
+
+	// Moving forward without allowing player to take action
+	g.setCurrentPlayer(p)
+
+	ps := p.State()
+	ps.Acted = true
+
+	// Fold if player is facing a bet, otherwise check
+	if ps.Wager < g.gs.Status.CurrentWager {
+		ps.Fold = true
+		ps.DidAction = "fold"
+		g.UpdateLastAction(p.SeatIndex(), "fold", 0)
+		return
+	}
+
+	ps.DidAction = "check"
+	g.UpdateLastAction(p.SeatIndex(), "check", 0)
+}
+
+func (g *game) appendActionHistory(source int, aType string, value int64) {
+	g.gs.Status.ActionHistory = append(g.gs.Status.ActionHistory, &Action{
+		Source: source,
+		Type:   aType,
+		Value:  value,
+	})
+}
+
 func (g *game) UpdateLastAction(source int, aType string, value int64) error {
 
+	g.appendActionHistory(source, aType, value)
+
 	if g.gs.Status.LastAction == nil {
 		g.gs.Status.LastAction = &Action{
 			Source: source,
@@ -468,6 +1179,7 @@ func (g *game) UpdateLastAction(source int, aType string, value int64) error {
 	g.gs.Status.LastAction.Source = source
 	g.gs.Status.LastAction.Type = aType
 	g.gs.Status.LastAction.Value = value
+	g.gs.Status.LastAction.Fraction = 0
 
 	return nil
 }
@@ -475,15 +1187,159 @@ func (g *game) UpdateLastAction(source int, aType string, value int64) error {
 func (g *game) GetAllowedActions(p Player) []string {
 
 	// player is movable for this round
-	if g.gs.Status.CurrentPlayer == p.SeatIndex() {
+	if p != nil && g.gs.Status.CurrentPlayer == p.SeatIndex() {
 		return g.GetAvailableActions(p)
 	}
 
 	return make([]string, 0)
 }
 
+// PreviewActions returns actions which the player would be allowed to take if it were the turn of
+// player right now. Current player is not changed.
+func (g *game) PreviewActions(idx int) []string {
+
+	p := g.Player(idx)
+	if p == nil || g.IsHandComplete() {
+		return make([]string, 0)
+	}
+
+	ps := p.State()
+	if ps.Empty || ps.Fold {
+		return make([]string, 0)
+	}
+
+	return g.GetAvailableActions(p)
+}
+
+// CallAmount returns chips which a call would actually cost the player, that is capped at the
+// stack of player.
+func (g *game) CallAmount(idx int) int64 {
+
+	p := g.Player(idx)
+	if p == nil {
+		return 0
+	}
+
+	ps := p.State()
+	if ps.Wager >= g.gs.Status.CurrentWager {
+		return 0
+	}
+
+	// Big blind which was posted short still has to be called in full
+	delta := g.gs.Status.CurrentWager - ps.Wager
+	if g.gs.Status.Round == "preflop" && g.gs.Status.CurrentWager < g.gs.Meta.Blind.BB {
+		delta = g.gs.Meta.Blind.BB - ps.Wager
+	}
+
+	if delta > ps.StackSize {
+		return ps.StackSize
+	}
+
+	return delta
+}
+
+// IsCallAllin returns true if calling the current wager takes all chips of player, in which case
+// "allin" is offered instead of "call" and going all-in is just a call.
+func (g *game) IsCallAllin(idx int) bool {
+
+	p := g.Player(idx)
+	if p == nil || p.State().Fold {
+		return false
+	}
+
+	amount := g.CallAmount(idx)
+
+	return amount > 0 && amount == p.State().StackSize
+}
+
+// HandActionCounts returns the number of voluntary bets, raises and calls which player took in this
+// hand.
+func (g *game) HandActionCounts(idx int) ActionCounts {
+
+	p := g.Player(idx)
+	if p == nil {
+		return ActionCounts{}
+	}
+
+	return p.State().ActionCounts
+}
+
+// MinBet returns the minimum chips of a bet for the current player, which is capped at the stack of
+// player because betting whole stack is always allowed. It returns 0 if there is no current player.
+func (g *game) MinBet() int64 {
+
+	p := g.GetCurrentPlayer()
+	if p == nil {
+		return 0
+	}
+
+	chips := g.gs.Status.MiniBet
+	if g.gs.Meta.Limit == "spread-limit" && chips < g.gs.Meta.LimitSetting.Min {
+		chips = g.gs.Meta.LimitSetting.Min
+	}
+
+	if chips > p.State().StackSize {
+		return p.State().StackSize
+	}
+
+	return chips
+}
+
+// MinRaise returns the minimum chip level which the current player is able to raise to, which is
+// capped at the stack of player because going all-in is always allowed. It returns 0 if there is no
+// current player.
+func (g *game) MinRaise() int64 {
+
+	p := g.GetCurrentPlayer()
+	if p == nil {
+		return 0
+	}
+
+	raised := g.gs.Status.PreviousRaiseSize
+	if g.gs.Meta.Limit == "spread-limit" && raised < g.gs.Meta.LimitSetting.Min {
+		raised = g.gs.Meta.LimitSetting.Min
+	}
+
+	chipLevel := g.gs.Status.CurrentWager + raised
+	if chipLevel > p.State().InitialStackSize {
+		return p.State().InitialStackSize
+	}
+
+	return chipLevel
+}
+
+// GetAvailableActions returns actions which player is able to take, excluding actions disabled for
+// the current round by options.
 func (g *game) GetAvailableActions(p Player) []string {
 
+	actions := g.getAvailableActions(p)
+
+	disabled := g.gs.Meta.DisabledActions[g.gs.Status.Round]
+	if len(disabled) == 0 {
+		return actions
+	}
+
+	filtered := make([]string, 0, len(actions))
+	for _, action := range actions {
+
+		allowed := true
+		for _, d := range disabled {
+			if action == d {
+				allowed = false
+				break
+			}
+		}
+
+		if allowed {
+			filtered = append(filtered, action)
+		}
+	}
+
+	return filtered
+}
+
+func (g *game) getAvailableActions(p Player) []string {
+
 	actions := make([]string, 0)
 
 	// Invalid
@@ -502,7 +1358,21 @@ func (g *game) GetAvailableActions(p Player) []string {
 	if ps.StackSize == 0 {
 		actions = append(actions, "pass")
 		return actions
-	} else {
+	}
+
+	// Player who is sitting out can only fold or check
+	if ps.SitOut {
+		if ps.Wager < g.gs.Status.CurrentWager {
+			actions = append(actions, "fold")
+		} else {
+			actions = append(actions, "check")
+		}
+
+		return actions
+	}
+
+	// Going all-in for more than the current wager is a raise
+	if (g.isRaiseAllowed() && !ps.RaiseClosed) || ps.InitialStackSize <= g.gs.Status.CurrentWager {
 		actions = append(actions, "allin")
 	}
 
@@ -514,8 +1384,13 @@ func (g *game) GetAvailableActions(p Player) []string {
 
 			actions = append(actions, "call")
 
+			// complete the incomplete bet to a full bet
+			if g.gs.Status.CurrentWager < g.gs.Status.MiniBet && ps.InitialStackSize > g.gs.Status.MiniBet {
+				actions = append(actions, "complete")
+			}
+
 			// raise
-			if ps.InitialStackSize > g.gs.Status.CurrentWager+g.gs.Status.PreviousRaiseSize {
+			if ps.InitialStackSize > g.gs.Status.CurrentWager+g.gs.Status.PreviousRaiseSize && g.isRaiseAllowed() && !ps.RaiseClosed {
 				actions = append(actions, "raise")
 			}
 		}
@@ -526,7 +1401,7 @@ func (g *game) GetAvailableActions(p Player) []string {
 		if ps.InitialStackSize >= g.gs.Status.MiniBet {
 			if g.gs.Status.CurrentWager == 0 {
 				actions = append(actions, "bet")
-			} else {
+			} else if g.isRaiseAllowed() {
 				actions = append(actions, "raise")
 			}
 		}
@@ -535,24 +1410,62 @@ func (g *game) GetAvailableActions(p Player) []string {
 	return actions
 }
 
+func (g *game) isRaiseAllowed() bool {
+
+	// Unlimited
+	if g.gs.Meta.MaxRaisesPerStreet == 0 {
+		return true
+	}
+
+	return g.gs.Status.RaiseCount < g.gs.Meta.MaxRaisesPerStreet
+}
+
 func (g *game) Start() error {
 
-	// Check the number of players
-	if g.GetPlayerCount() < 2 {
+	if g.optionsErr != nil {
+		return g.optionsErr
+	}
+
+	// Check the number of players who have chips to play, seats without chips are not counted
+	if g.getDealtInPlayerCount() < 2 {
 		return ErrInsufficientNumberOfPlayers
 	}
 
+	if g.gs.Meta.MaxSeats > 0 && g.GetPlayerCount() > g.gs.Meta.MaxSeats {
+		return ErrTooManyPlayers
+	}
+
 	// Require dealer
-	if g.dealer == nil {
+	if g.dealer == nil || g.dealer.State().Empty {
 		return ErrNoDealer
 	}
 
+	positions := make([][]string, 0, len(g.gs.Players))
+	for _, p := range g.gs.Players {
+		positions = append(positions, p.Positions)
+	}
+
+	err := validatePositions(positions)
+	if err != nil {
+		return err
+	}
+
 	// Check backroll
 	for _, p := range g.gs.Players {
 
-		if p.Bankroll <= 0 {
+		if p.Empty {
+			continue
+		}
+
+		if p.Bankroll < 0 {
 			return ErrNotEnoughBackroll
 		}
+
+		// Player who has no chips is still allowed to hold the button (dead button)
+		if p.Bankroll == 0 {
+			p.Fold = true
+			p.Combination = nil
+		}
 	}
 
 	// No desk was set
@@ -560,6 +1473,34 @@ func (g *game) Start() error {
 		return ErrNoDeck
 	}
 
+	// Hole cards settings should match the game type
+	err = validateHoleCardsRule(g.gs.Meta.GameType, g.gs.Meta.HoleCardsCount, g.gs.Meta.RequiredHoleCardsCount)
+	if err != nil {
+		return err
+	}
+
+	// Forced bets have to respect the minimum chip unit
+	err = validateForcedBetChipUnit(g.gs.Meta.Ante, g.gs.Meta.Blind, g.gs.Meta.MinChipUnit)
+	if err != nil {
+		return err
+	}
+
+	for _, count := range g.gs.Meta.BoardLayout {
+		if count <= 0 {
+			return ErrInvalidGameConfig
+		}
+	}
+
+	err = g.validatePresetCards()
+	if err != nil {
+		return err
+	}
+
+	err = g.validateRunCount()
+	if err != nil {
+		return err
+	}
+
 	// Initializing game status
 	g.gs.Status.Pots = make([]*pot.Pot, 0)
 	g.gs.Status.Board = make([]string, 0)
@@ -571,8 +1512,38 @@ func (g *game) Start() error {
 
 func (g *game) Initialize() error {
 
+	// Seed is useless if deck is used as-is
+	if g.noShuffle {
+		g.gs.Meta.ShuffleSeed = 0
+	}
+
 	// Shuffle cards
-	g.gs.Meta.Deck = ShuffleCards(g.gs.Meta.Deck)
+	seed := g.gs.Meta.ShuffleSeed
+	if !g.noShuffle {
+		shuffle := ShuffleCards
+		if g.shuffler != nil {
+			shuffle = g.shuffler
+		}
+
+		if seed != 0 {
+			g.gs.Meta.Deck = ShuffleCardsWithSeed(g.gs.Meta.Deck, seed, g.cut)
+		} else {
+			g.gs.Meta.Deck = shuffle(g.gs.Meta.Deck)
+
+			// Cut cards after shuffling
+			if g.cut {
+				g.gs.Meta.Deck = CutCardsRandom(g.gs.Meta.Deck)
+			}
+		}
+	}
+
+	// Preset cards take place of shuffled cards
+	g.arrangePresetCards()
+
+	// Deck which cannot be reproduced by seed is recorded by fingerprint for dispute resolution
+	if seed == 0 {
+		g.gs.Meta.DeckFingerprint = DeckFingerprint(g.gs.Meta.Deck)
+	}
 
 	// Initialize minimum bet
 	if g.gs.Meta.Blind.Dealer > g.gs.Meta.Blind.BB {
@@ -581,7 +1552,10 @@ func (g *game) Initialize() error {
 		g.gs.Status.MiniBet = g.gs.Meta.Blind.BB
 	}
 
-	g.ResetRoundStatus()
+	err := g.ResetRoundStatus()
+	if err != nil {
+		return err
+	}
 
 	return g.EmitEvent(GameEvent_Initialized)
 }
@@ -605,7 +1579,7 @@ func (g *game) RequestAnte() error {
 func (g *game) RequestBlinds() error {
 
 	// No need to pay blinds
-	if g.gs.Meta.Blind.Dealer == 0 && g.gs.Meta.Blind.SB == 0 && g.gs.Meta.Blind.BB > 0 {
+	if g.forcedBet == nil && g.gs.Meta.Blind.Dealer == 0 && g.gs.Meta.Blind.SB == 0 && g.gs.Meta.Blind.BB > 0 {
 		return g.EmitEvent(GameEvent_BlindsPaid)
 	}
 
@@ -632,27 +1606,61 @@ func (g *game) Next() error {
 
 func (g *game) nextRound() error {
 
-	g.ResetRoundStatus()
+	err := g.ResetRoundStatus()
+	if err != nil {
+		return err
+	}
+
 	g.ResetAllPlayerStatus()
 
-	if g.GetAlivePlayerCount() == 1 {
+	if g.GetAlivePlayerCount() <= 1 {
 		// Game is completed
 		return g.EmitEvent(GameEvent_GameCompleted)
 	}
 
-	// Going to the next round
+	// No one is able to bet anymore, so that the rest of board is run out
+	if !g.gs.Status.Runout && g.GetMovablePlayerCount() <= 1 {
+		g.gs.Status.Runout = true
+		g.gs.Status.RunoutStreet = g.dealtStreetCount()
+	}
+
 	switch g.gs.Status.Round {
-	case "preflop":
+	case "preflop", "flop", "turn", "river":
+	default:
+		return ErrUnknownRound
+	}
+
+	// Going to the next round, the first street after preflop is flop and the last one is river
+	layout := g.boardLayout()
+	street := g.dealtStreetCount()
+	switch {
+	case street >= len(layout):
+		return g.EmitEvent(GameEvent_GameCompleted)
+	case street == 0:
 		return g.EnterFlopRound()
-	case "flop":
-		return g.EnterTurnRound()
-	case "turn":
+	case street == len(layout)-1:
 		return g.EnterRiverRound()
-	case "river":
-		return g.EmitEvent(GameEvent_GameCompleted)
 	}
 
-	return ErrUnknownRound
+	return g.EnterTurnRound()
+}
+
+func (g *game) boardLayout() []int {
+	return g.gs.boardLayout()
+}
+
+// dealtStreetCount returns the number of streets after preflop whose board cards were dealt already.
+func (g *game) dealtStreetCount() int {
+
+	dealt := 0
+	for i, count := range g.boardLayout() {
+		dealt += count
+		if dealt > len(g.gs.Status.Board) {
+			return i
+		}
+	}
+
+	return len(g.boardLayout())
 }
 
 func (g *game) EnterPreflopRound() error {
@@ -682,30 +1690,34 @@ func (g *game) InitializeRound() error {
 	case "preflop":
 
 		// Deal cards to players
-		for _, p := range g.gs.Players {
-			p.HoleCards = g.Deal(g.gs.Meta.HoleCardsCount)
-		}
-	case "flop":
+		if g.gs.Meta.DealOneAtATime {
+			for _, idx := range g.holeCardsDealingOrder() {
+				ps := g.gs.GetPlayer(idx)
+				ps.HoleCards = append(ps.HoleCards, g.dealTo(fmt.Sprintf("hole:%d", idx), 1)...)
+			}
 
-		g.Burn(1)
+			break
+		}
 
-		// Deal 3 board cards
-		g.gs.Status.Board = append(g.gs.Status.Board, g.Deal(3)...)
+		for _, p := range g.gs.Players {
+			if p.isDealtOut() {
+				continue
+			}
 
-		// Start at dealer
-		_, err := g.StartAtDealer()
-		if err != nil {
-			return err
+			p.HoleCards = g.dealTo(fmt.Sprintf("hole:%d", p.Idx), g.gs.Meta.HoleCardsCount)
 		}
+	case "flop", "turn", "river":
 
-	case "turn":
-		fallthrough
-	case "river":
+		layout := g.boardLayout()
+		street := g.dealtStreetCount()
+		if street >= len(layout) {
+			return ErrUnknownRound
+		}
 
 		g.Burn(1)
 
-		// Deal board card
-		g.gs.Status.Board = append(g.gs.Status.Board, g.Deal(1)...)
+		// Deal board cards of this street
+		g.gs.Status.Board = append(g.gs.Status.Board, g.dealTo("board", layout[street])...)
 
 		// Start at dealer
 		_, err := g.StartAtDealer()
@@ -750,11 +1762,30 @@ func (g *game) StartRound() error {
 			return g.EmitEvent(GameEvent_RoundClosed)
 		}
 
+		// The player next to the one who posted forced bet is the first player
+		if g.forcedBet != nil {
+			p := g.Player(g.gs.Status.CurrentRaiser)
+			if p == nil {
+				return ErrNotFoundPlayer
+			}
+
+			g.SetCurrentPlayer(p)
+			return g.EmitEvent(GameEvent_RoundStarted)
+		}
+
 		// Set Dealer to the first player
-		g.SetCurrentPlayer(g.Dealer())
+		dealer := g.Dealer()
+		if dealer == nil {
+			return ErrNotFoundDealer
+		}
+
+		g.SetCurrentPlayer(dealer)
 
 		for i := 0; i < g.GetPlayerCount(); i++ {
 			p := g.NextPlayer()
+			if p == nil {
+				return ErrNotFoundPlayer
+			}
 
 			if p.CheckPosition("bb") {
 				g.SetCurrentPlayer(g.NextPlayer())
@@ -766,7 +1797,7 @@ func (g *game) StartRound() error {
 
 	} else {
 
-		_, err := g.StartAtDealer()
+		err := g.startPostflopRound()
 		if err != nil {
 			return err
 		}
@@ -775,6 +1806,49 @@ func (g *game) StartRound() error {
 	return g.EmitEvent(GameEvent_RoundStarted)
 }
 
+// startPostflopRound moves to the player right before the first player to act by the first-to-act rule,
+// so that action is requested from the first player when round started. Folded and all-in players
+// are treated as usual, they are requested to pass.
+func (g *game) startPostflopRound() error {
+
+	kind, seat, err := parseFirstToActRule(g.gs.Meta.FirstToActRule)
+	if err != nil {
+		return err
+	}
+
+	switch kind {
+	case FirstToActRule_Dealer:
+		dealer := g.Dealer()
+		if dealer == nil {
+			return ErrNotFoundDealer
+		}
+
+		seat = dealer.SeatIndex()
+	case FirstToActRule_Explicit:
+		if seat >= g.GetPlayerCount() {
+			return ErrNotFoundPlayer
+		}
+	default:
+		// Player next to dealer acts first
+		_, err := g.StartAtDealer()
+		return err
+	}
+
+	// Find the player before the first player to act, seats which are dealt out are skipped
+	playerCount := g.GetPlayerCount()
+	for i := 1; i <= playerCount; i++ {
+
+		ps := g.gs.Players[(seat-i+playerCount)%playerCount]
+		if ps.isDealtOut() {
+			continue
+		}
+
+		return g.SetCurrentPlayer(g.Player(ps.Idx))
+	}
+
+	return ErrNotFoundPlayer
+}
+
 func (g *game) PrintState() error {
 
 	data, err := g.GetStateJSON()
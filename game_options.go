@@ -1,32 +1,246 @@
 package pokerlib
 
-import "github.com/d-protocol/pokerlib/combination"
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/d-protocol/pokerlib/combination"
+)
+
+var (
+	ErrInvalidGameConfig = errors.New("game: invalid game config")
+	ErrInvalidPositions  = errors.New("game: invalid positions")
+)
+
+// Rules of who acts first in postflop rounds, explicit rule specifies the seat like "explicit:3".
+const (
+	FirstToActRule_Standard = "standard"
+	FirstToActRule_Dealer   = "dealer"
+	FirstToActRule_Explicit = "explicit"
+)
+
+// Rounding of small blind which is not a multiple of the minimum chip unit, such a small blind is
+// rejected if rounding is not set.
+const (
+	SBRounding_Up   = "up"
+	SBRounding_Down = "down"
+)
+
+// DefaultBoardLayout is the number of board cards dealt on flop, turn and river.
+var DefaultBoardLayout = []int{3, 1, 1}
+
+type HoleCardsRule struct {
+	HoleCardsCount         int
+	RequiredHoleCardsCount int
+}
+
+// Hole cards rules for game types
+var GameTypeHoleCardsRules = map[string]HoleCardsRule{
+	"holdem": {HoleCardsCount: 2, RequiredHoleCardsCount: 0},
+	"omaha":  {HoleCardsCount: 4, RequiredHoleCardsCount: 2},
+}
 
 type GameOptions struct {
+	GameType               string                    `json:"game_type,omitempty"`
 	Ante                   int64                     `json:"ante"`
+	LiveAnte               bool                      `json:"live_ante,omitempty"` // ante counts toward wager of preflop
 	Blind                  BlindSetting              `json:"blind"`
 	Limit                  string                    `json:"limit"`
+	LimitSetting           LimitSetting              `json:"limit_setting"` // bounds of bet for spread-limit
 	HoleCardsCount         int                       `json:"hole_cards_count"`
 	RequiredHoleCardsCount int                       `json:"required_hole_cards_count"`
 	CombinationPowers      []combination.Combination `json:"combination_powers"`
 	Deck                   []string                  `json:"deck"`
 	BurnCount              int                       `json:"burn_count"`
+	DealOneAtATime         bool                      `json:"deal_one_at_a_time,omitempty"`
+	RunCount               int                       `json:"run_count,omitempty"`
+	MaxRaisesPerStreet     int                       `json:"max_raises_per_street"` // 0 is unlimited
+	MinChipUnit            int64                     `json:"min_chip_unit"`         // 0 is no limit
+	NoShuffle              bool                      `json:"no_shuffle"`            // deck is used as-is
+	Cut                    bool                      `json:"cut"`                   // cut cards after shuffling
+	BoardLayout            []int                     `json:"board_layout"`          // DefaultBoardLayout if empty
+	PresetBoard            []string                  `json:"preset_board,omitempty"`
+	DisabledActions        map[string][]string       `json:"disabled_actions,omitempty"`  // actions which are never offered in the round
+	FirstToActRule         string                    `json:"first_to_act_rule,omitempty"` // FirstToActRule_Standard if empty
+	MaxSeats               int                       `json:"max_seats,omitempty"`         // 0 is unlimited
+	ShuffleSeed            int64                     `json:"shuffle_seed,omitempty"`      // deck is shuffled reproducibly by seed if it is not 0
+	KillPot                KillPotSetting            `json:"kill_pot"`
+	Rake                   RakeSetting               `json:"rake"`
 	Players                []*PlayerSetting          `json:"players"`
+
+	// ForcedBet is consulted instead of blinds if it is set, which returns the seat that has to
+	// post a forced bet (e.g., bring-in of stud) and the amount.
+	ForcedBet func(gs *GameState) (seatIdx int, amount int64) `json:"-"`
+
+	// Shuffler replaces the default secure shuffling if it is set, which is for simulation only.
+	Shuffler Shuffler `json:"-"`
 }
 
 type BlindSetting struct {
-	Dealer int64 `json:"dealer"`
-	SB     int64 `json:"sb"`
-	BB     int64 `json:"bb"`
+	Dealer     int64  `json:"dealer"`
+	SB         int64  `json:"sb"`
+	BB         int64  `json:"bb"`
+	SBRounding string `json:"sb_rounding,omitempty"` // SBRounding_Up or SBRounding_Down
+}
+
+// LimitSetting is the range of chips which a bet or raise can add in spread-limit games.
+type LimitSetting struct {
+	Min int64 `json:"min"`
+	Max int64 `json:"max"`
+}
+
+// KillPotSetting is for limit games which double stakes for the next hand after a player won a big
+// pot, Threshold is the pot size to kill the pot and 0 disables kill pot.
+type KillPotSetting struct {
+	Threshold int64 `json:"threshold"`
+}
+
+// RakeSetting is the rake taken from pots, BasisPoints is the rate in 1/10000 and Cap is the maximum
+// rake of a hand which is unlimited if it is 0.
+type RakeSetting struct {
+	BasisPoints int64 `json:"basis_points"`
+	Cap         int64 `json:"cap"`
 }
 
 type PlayerSetting struct {
 	PlayerID  string   `json:"player_id"`
 	Bankroll  int64    `json:"bankroll"`
 	Positions []string `json:"positions"`
+	SitOut    bool     `json:"sit_out,omitempty"`
+	Empty     bool     `json:"empty,omitempty"`  // seat without player
+	Killed    bool     `json:"killed,omitempty"` // player who killed the pot posts kill blind and stakes double
+
+	// Player who returns from sitting out posts a big blind before being dealt in
+	MustPostBlind bool `json:"must_post_blind,omitempty"`
+
+	// Player who missed blinds posts them as a dead blind, which goes straight to the pot
+	MissedBlind int64 `json:"missed_blind,omitempty"`
+
+	// Time bank in milliseconds which player is able to use in addition to the regular time to act
+	TimeBank int64 `json:"time_bank,omitempty"`
+
+	// Preset cards are dealt in place of shuffled cards, which is for scenario testing
+	PresetHoleCards []string `json:"preset_hole_cards,omitempty"`
 }
 
-func NewStardardGameOptions() *GameOptions {
+// NewPlayer returns setting of a player with positions, e.g., "dealer" and "sb" for heads-up.
+func NewPlayer(bankroll int64, positions ...string) *PlayerSetting {
+	return &PlayerSetting{
+		Bankroll:  bankroll,
+		Positions: append([]string{}, positions...),
+	}
+}
+
+func NewDealer(bankroll int64) *PlayerSetting {
+	return NewPlayer(bankroll, "dealer")
+}
+
+func NewSmallBlind(bankroll int64) *PlayerSetting {
+	return NewPlayer(bankroll, "sb")
+}
+
+func NewBigBlind(bankroll int64) *PlayerSetting {
+	return NewPlayer(bankroll, "bb")
+}
+
+// Validate checks if options are able to start a game, e.g., seats, positions, forced bets, board
+// layout, rake and hole cards settings of the game type. Hole cards settings are filled by game type
+// if they are not specified.
+func (opts *GameOptions) Validate() error {
+
+	// Players are seated by index, so every player takes a seat
+	if opts.MaxSeats > 0 && len(opts.Players) > opts.MaxSeats {
+		return ErrTooManyPlayers
+	}
+
+	positions := make([][]string, 0, len(opts.Players))
+	for _, p := range opts.Players {
+		if !p.Empty {
+			positions = append(positions, p.Positions)
+		}
+	}
+
+	err := validatePositions(positions)
+	if err != nil {
+		return err
+	}
+
+	// Only one player could kill the pot
+	killed := 0
+	for _, p := range opts.Players {
+		if p.Killed {
+			killed++
+		}
+	}
+
+	if killed > 1 || (killed == 1 && opts.KillPot.Threshold <= 0) {
+		return ErrInvalidGameConfig
+	}
+
+	if opts.RunCount < 0 {
+		return ErrInvalidGameConfig
+	}
+
+	switch opts.Blind.SBRounding {
+	case "", SBRounding_Up, SBRounding_Down:
+	default:
+		return ErrInvalidGameConfig
+	}
+
+	// Forced bets have to respect the minimum chip unit
+	err = validateForcedBetChipUnit(opts.Ante, opts.Blind, opts.MinChipUnit)
+	if err != nil {
+		return err
+	}
+
+	for _, count := range opts.BoardLayout {
+		if count <= 0 {
+			return ErrInvalidGameConfig
+		}
+	}
+
+	if opts.Rake.BasisPoints < 0 || opts.Rake.BasisPoints > 10000 || opts.Rake.Cap < 0 {
+		return ErrInvalidGameConfig
+	}
+
+	if opts.Limit == "spread-limit" && (opts.LimitSetting.Min <= 0 || opts.LimitSetting.Max < opts.LimitSetting.Min) {
+		return ErrInvalidGameConfig
+	}
+
+	_, seat, err := parseFirstToActRule(opts.FirstToActRule)
+	if err != nil || seat >= len(opts.Players) {
+		return ErrInvalidGameConfig
+	}
+
+	rule, ok := GameTypeHoleCardsRules[opts.GameType]
+	if !ok {
+		return nil
+	}
+
+	if opts.HoleCardsCount == 0 && opts.RequiredHoleCardsCount == 0 {
+		opts.HoleCardsCount = rule.HoleCardsCount
+		opts.RequiredHoleCardsCount = rule.RequiredHoleCardsCount
+	}
+
+	return validateHoleCardsRule(opts.GameType, opts.HoleCardsCount, opts.RequiredHoleCardsCount)
+}
+
+func validateHoleCardsRule(gameType string, holeCardsCount int, requiredHoleCardsCount int) error {
+
+	rule, ok := GameTypeHoleCardsRules[gameType]
+	if !ok {
+		return nil
+	}
+
+	if holeCardsCount != rule.HoleCardsCount || requiredHoleCardsCount != rule.RequiredHoleCardsCount {
+		return ErrInvalidGameConfig
+	}
+
+	return nil
+}
+
+func NewStandardGameOptions() *GameOptions {
 	return &GameOptions{
 		Ante: 0,
 		Blind: BlindSetting{
@@ -43,10 +257,120 @@ func NewStardardGameOptions() *GameOptions {
 		Players:                make([]*PlayerSetting, 0),
 	}
 }
+
+// NewStardardGameOptions forwards to NewStandardGameOptions.
+//
+// Deprecated: Use NewStandardGameOptions instead.
+func NewStardardGameOptions() *GameOptions {
+	return NewStandardGameOptions()
+}
+
 func NewShortDeckGameOptions() *GameOptions {
 
-	opts := NewStardardGameOptions()
+	opts := NewStandardGameOptions()
 	opts.CombinationPowers = combination.CombinationPowerShortDeck
 
 	return opts
 }
+
+func NewOmahaGameOptions() *GameOptions {
+
+	rule := GameTypeHoleCardsRules["omaha"]
+
+	opts := NewStandardGameOptions()
+	opts.GameType = "omaha"
+	opts.HoleCardsCount = rule.HoleCardsCount
+	opts.RequiredHoleCardsCount = rule.RequiredHoleCardsCount
+
+	return opts
+}
+
+// validatePositions checks if there is exactly one dealer and at most one small blind and big blind,
+// otherwise positions of players overwrite each other.
+func validatePositions(positions [][]string) error {
+
+	counts := make(map[string]int)
+	for _, ps := range positions {
+		for _, position := range ps {
+			counts[position]++
+		}
+	}
+
+	if counts["dealer"] != 1 || counts["sb"] > 1 || counts["bb"] > 1 {
+		return ErrInvalidPositions
+	}
+
+	return nil
+}
+
+func validateForcedBetChipUnit(ante int64, blind BlindSetting, unit int64) error {
+
+	for _, chips := range []int64{ante, blind.Dealer, roundSmallBlind(blind, unit), blind.BB} {
+		if !isChipUnitMultiple(chips, unit) {
+			return ErrChipUnitViolation
+		}
+	}
+
+	return nil
+}
+
+// roundSmallBlind returns small blind which is rounded to a multiple of the minimum chip unit by the
+// rounding rule of blinds, small blind is unchanged if no rounding is set.
+func roundSmallBlind(blind BlindSetting, unit int64) int64 {
+
+	if isChipUnitMultiple(blind.SB, unit) {
+		return blind.SB
+	}
+
+	switch blind.SBRounding {
+	case SBRounding_Down:
+		return blind.SB - blind.SB%unit
+	case SBRounding_Up:
+		return blind.SB - blind.SB%unit + unit
+	}
+
+	return blind.SB
+}
+
+func isChipUnitMultiple(chips int64, unit int64) bool {
+
+	if unit <= 0 {
+		return true
+	}
+
+	return chips%unit == 0
+}
+
+// isWithinSpreadLimit checks chips added by a bet or raise, an all-in for less than the minimum is
+// exempted by callers.
+func isWithinSpreadLimit(chips int64, limit string, setting LimitSetting) bool {
+
+	if limit != "spread-limit" {
+		return true
+	}
+
+	return chips >= setting.Min && chips <= setting.Max
+}
+
+// parseFirstToActRule returns kind of rule and the seat for explicit rule, seat is -1 for other rules.
+func parseFirstToActRule(rule string) (string, int, error) {
+
+	switch rule {
+	case "", FirstToActRule_Standard:
+		return FirstToActRule_Standard, -1, nil
+	case FirstToActRule_Dealer:
+		return FirstToActRule_Dealer, -1, nil
+	}
+
+	prefix := FirstToActRule_Explicit + ":"
+	if !strings.HasPrefix(rule, prefix) {
+		return "", -1, ErrInvalidGameConfig
+	}
+
+	seat, err := strconv.Atoi(strings.TrimPrefix(rule, prefix))
+	if err != nil || seat < 0 {
+		return "", -1, ErrInvalidGameConfig
+	}
+
+	return FirstToActRule_Explicit, seat, nil
+}
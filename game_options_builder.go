@@ -0,0 +1,87 @@
+package pokerlib
+
+// GameOptionsBuilder builds game options with fluent configuration, which is based on the standard
+// game options.
+type GameOptionsBuilder struct {
+	opts *GameOptions
+}
+
+func NewGameOptionsBuilder() *GameOptionsBuilder {
+	return &GameOptionsBuilder{
+		opts: NewStandardGameOptions(),
+	}
+}
+
+func (b *GameOptionsBuilder) GameType(gameType string) *GameOptionsBuilder {
+	b.opts.GameType = gameType
+	return b
+}
+
+func (b *GameOptionsBuilder) Limit(limit string) *GameOptionsBuilder {
+	b.opts.Limit = limit
+	return b
+}
+
+func (b *GameOptionsBuilder) Blinds(sb int64, bb int64) *GameOptionsBuilder {
+	b.opts.Blind.SB = sb
+	b.opts.Blind.BB = bb
+	return b
+}
+
+func (b *GameOptionsBuilder) Ante(chips int64) *GameOptionsBuilder {
+	b.opts.Ante = chips
+	return b
+}
+
+func (b *GameOptionsBuilder) HoleCards(count int) *GameOptionsBuilder {
+	b.opts.HoleCardsCount = count
+	return b
+}
+
+func (b *GameOptionsBuilder) Deck(deck []string) *GameOptionsBuilder {
+	b.opts.Deck = deck
+	return b
+}
+
+func (b *GameOptionsBuilder) AddPlayer(setting *PlayerSetting) *GameOptionsBuilder {
+	b.opts.Players = append(b.opts.Players, setting)
+	return b
+}
+
+// Build validates and returns game options, so that errors of settings are caught before the game
+// is started.
+func (b *GameOptionsBuilder) Build() (*GameOptions, error) {
+
+	if len(b.opts.Deck) == 0 {
+		return nil, ErrNoDeck
+	}
+
+	if len(b.opts.Players) < 2 {
+		return nil, ErrInsufficientNumberOfPlayers
+	}
+
+	hasDealer := false
+	for _, p := range b.opts.Players {
+
+		if p.Bankroll <= 0 {
+			return nil, ErrNotEnoughBackroll
+		}
+
+		for _, position := range p.Positions {
+			if position == "dealer" {
+				hasDealer = true
+			}
+		}
+	}
+
+	if !hasDealer {
+		return nil, ErrNoDealer
+	}
+
+	err := b.opts.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return b.opts, nil
+}
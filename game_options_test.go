@@ -0,0 +1,307 @@
+package pokerlib
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GameOptions_GameType(t *testing.T) {
+
+	// Hole cards settings are filled by game type
+	opts := newTestGameOptions(10000, 10000, 10000)
+	opts.GameType = "omaha"
+	opts.HoleCardsCount = 0
+	opts.RequiredHoleCardsCount = 0
+	assert.Nil(t, opts.Validate())
+	assert.Equal(t, 4, opts.HoleCardsCount)
+	assert.Equal(t, 2, opts.RequiredHoleCardsCount)
+
+	g := NewGame(opts)
+	assert.Nil(t, g.Start())
+	assert.Nil(t, g.ReadyForAll())
+	assert.Nil(t, g.PayBlinds())
+	for _, p := range g.GetState().Players {
+		assert.Equal(t, 4, len(p.HoleCards))
+	}
+
+	// Hold'em
+	opts = newTestGameOptions(10000, 10000, 10000)
+	opts.GameType = "holdem"
+	assert.Nil(t, opts.Validate())
+	assert.Nil(t, NewGame(opts).Start())
+
+	// Dealing 2 cards but requiring 2-from-4 evaluation
+	opts = newTestGameOptions(10000, 10000, 10000)
+	opts.GameType = "omaha"
+	opts.HoleCardsCount = 2
+	opts.RequiredHoleCardsCount = 2
+	assert.Equal(t, ErrInvalidGameConfig, opts.Validate())
+
+	g = NewGame(opts)
+	assert.Equal(t, ErrInvalidGameConfig, g.Start())
+
+	// Hold'em with 4 hole cards
+	opts = newTestGameOptions(10000, 10000, 10000)
+	opts.GameType = "holdem"
+	opts.HoleCardsCount = 4
+	assert.Equal(t, ErrInvalidGameConfig, opts.Validate())
+	assert.Equal(t, ErrInvalidGameConfig, NewGame(opts).ApplyOptions(opts))
+}
+
+func Test_GameOptions_MinChipUnit(t *testing.T) {
+
+	opts := newTestGameOptions(10000, 10000, 10000)
+	opts.MinChipUnit = 10
+	assert.Equal(t, ErrChipUnitViolation, opts.Validate())
+	assert.Equal(t, ErrChipUnitViolation, NewGame(opts).Start())
+
+	opts.Blind.SB = 10
+	opts.Blind.BB = 20
+	opts.Ante = 10
+	assert.Nil(t, opts.Validate())
+}
+
+func Test_GameOptions_SBRounding(t *testing.T) {
+
+	// Small blind of 15 doesn't divide by the chip unit
+	opts := newTestGameOptions(10000, 10000, 10000)
+	opts.MinChipUnit = 10
+	opts.Blind.SB = 15
+	opts.Blind.BB = 30
+	assert.Equal(t, ErrChipUnitViolation, opts.Validate())
+
+	opts.Blind.SBRounding = "nearest"
+	assert.Equal(t, ErrInvalidGameConfig, opts.Validate())
+
+	cases := map[string]int64{
+		SBRounding_Up:   20,
+		SBRounding_Down: 10,
+	}
+
+	for rounding, expected := range cases {
+		opts.Blind.SBRounding = rounding
+		assert.Nil(t, opts.Validate())
+
+		g := startTestGame(t, opts)
+		assert.Equal(t, expected, g.GetState().Meta.Blind.SB, rounding)
+		assert.Equal(t, expected, g.Player(1).State().Wager, rounding)
+		assert.Equal(t, int64(30), g.Player(2).State().Wager, rounding)
+	}
+}
+
+func Test_GameOptions_InvalidOptions(t *testing.T) {
+
+	opts := newTestGameOptions(10000, 10000, 10000)
+	opts.Rake.BasisPoints = 20000
+	g := NewGame(opts)
+	assert.Equal(t, ErrInvalidGameConfig, g.Start())
+	assert.Empty(t, g.GetState().Status.CurrentEvent)
+
+	opts = newTestGameOptions(10000, 10000, 10000)
+	opts.BoardLayout = []int{3, -1, 1}
+	assert.Equal(t, ErrInvalidGameConfig, NewGame(opts).Start())
+	assert.Equal(t, ErrInvalidGameConfig, NewGame(opts).Clone().Start())
+
+	// Invalid options are not applied
+	g = NewGame(newTestGameOptions(10000, 10000, 10000))
+	assert.Equal(t, ErrInvalidGameConfig, g.ApplyOptions(opts))
+	assert.Equal(t, ErrInvalidGameConfig, g.Start())
+	assert.Empty(t, g.GetState().Meta.BoardLayout)
+}
+
+func Test_GameOptionsBuilder(t *testing.T) {
+
+	opts, err := NewGameOptionsBuilder().
+		Blinds(10, 20).
+		Ante(5).
+		HoleCards(2).
+		Deck(NewStandardDeckCards()).
+		AddPlayer(&PlayerSetting{Bankroll: 10000, Positions: []string{"dealer"}}).
+		AddPlayer(&PlayerSetting{Bankroll: 10000, Positions: []string{"sb"}}).
+		AddPlayer(&PlayerSetting{Bankroll: 10000, Positions: []string{"bb"}}).
+		Build()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10), opts.Blind.SB)
+	assert.Equal(t, int64(20), opts.Blind.BB)
+	assert.Equal(t, int64(5), opts.Ante)
+
+	g := NewGame(opts)
+	assert.Nil(t, g.Start())
+	assert.Equal(t, "ReadyRequested", g.GetEvent())
+}
+
+func Test_GameOptionsBuilder_Invalid(t *testing.T) {
+
+	// No deck
+	_, err := NewGameOptionsBuilder().
+		AddPlayer(&PlayerSetting{Bankroll: 10000, Positions: []string{"dealer", "sb"}}).
+		AddPlayer(&PlayerSetting{Bankroll: 10000, Positions: []string{"bb"}}).
+		Build()
+	assert.Equal(t, ErrNoDeck, err)
+
+	// No dealer
+	_, err = NewGameOptionsBuilder().
+		Deck(NewStandardDeckCards()).
+		AddPlayer(&PlayerSetting{Bankroll: 10000, Positions: []string{"sb"}}).
+		AddPlayer(&PlayerSetting{Bankroll: 10000, Positions: []string{"bb"}}).
+		Build()
+	assert.Equal(t, ErrNoDealer, err)
+
+	// Hole cards settings do not match the game type
+	_, err = NewGameOptionsBuilder().
+		GameType("omaha").
+		HoleCards(2).
+		Deck(NewStandardDeckCards()).
+		AddPlayer(&PlayerSetting{Bankroll: 10000, Positions: []string{"dealer", "sb"}}).
+		AddPlayer(&PlayerSetting{Bankroll: 10000, Positions: []string{"bb"}}).
+		Build()
+	assert.Equal(t, ErrInvalidGameConfig, err)
+}
+
+func Test_GameOptions_Presets(t *testing.T) {
+
+	presets := map[string]struct {
+		opts      *GameOptions
+		deck      []string
+		holeCards int
+	}{
+		"standard":   {NewStandardGameOptions(), NewStandardDeckCards(), 2},
+		"short_deck": {NewShortDeckGameOptions(), NewShortDeckCards(), 2},
+		"omaha":      {NewOmahaGameOptions(), NewStandardDeckCards(), 4},
+	}
+
+	for name, preset := range presets {
+
+		opts := preset.opts
+		opts.Deck = preset.deck
+		opts.Players = newTestGameOptions(10000, 10000, 10000).Players
+
+		assert.Nil(t, opts.Validate(), name)
+
+		g := startTestGame(t, opts)
+		for _, p := range g.GetState().Players {
+			assert.Len(t, p.HoleCards, preset.holeCards, name)
+		}
+
+		// Playing to the end
+		for g.GetEvent() != "GameClosed" {
+			switch g.GetEvent() {
+			case "RoundClosed", "ReadyRequested":
+				assert.Nil(t, g.ReadyForAll(), name)
+			default:
+				if g.GetCurrentPlayer().CheckAction("check") {
+					assert.Nil(t, g.Check(), name)
+				} else {
+					assert.Nil(t, g.Call(), name)
+				}
+			}
+		}
+
+		assert.NotNil(t, g.GetState().Result, name)
+	}
+}
+
+func Test_GameOptions_StandardAlias(t *testing.T) {
+	assert.Equal(t, NewStandardGameOptions(), NewStardardGameOptions())
+}
+
+func Test_GameOptions_SpreadLimit(t *testing.T) {
+
+	opts := newTestGameOptions(10000, 10000, 10000)
+	opts.Limit = "spread-limit"
+	opts.LimitSetting = LimitSetting{Min: 10, Max: 2}
+	assert.Equal(t, ErrInvalidGameConfig, opts.Validate())
+}
+
+func Test_GameOptions_FirstToActRule(t *testing.T) {
+
+	for _, rule := range []string{"", "standard", "dealer", "explicit:2"} {
+		opts := newTestGameOptions(10000, 10000, 10000)
+		opts.FirstToActRule = rule
+		assert.Nil(t, opts.Validate(), rule)
+	}
+
+	for _, rule := range []string{"button", "explicit", "explicit:-1", "explicit:3", "explicit:x"} {
+		opts := newTestGameOptions(10000, 10000, 10000)
+		opts.FirstToActRule = rule
+		assert.Equal(t, ErrInvalidGameConfig, opts.Validate(), rule)
+	}
+}
+
+func Test_GameOptions_MaxSeats(t *testing.T) {
+
+	bankrolls := make([]int64, 10)
+	for i := range bankrolls {
+		bankrolls[i] = 10000
+	}
+
+	opts := newTestGameOptions(bankrolls...)
+	opts.MaxSeats = 8
+	assert.Equal(t, ErrTooManyPlayers, opts.Validate())
+
+	g := NewGame(opts)
+	assert.Equal(t, ErrTooManyPlayers, g.ApplyOptions(opts))
+	assert.Equal(t, ErrTooManyPlayers, g.Start())
+
+	// Unlimited if it is not set
+	opts = newTestGameOptions(bankrolls...)
+	assert.Nil(t, opts.Validate())
+	assert.Nil(t, NewGame(opts).Start())
+}
+
+func Test_GameOptions_PlayerSettingConstructors(t *testing.T) {
+
+	opts, err := NewGameOptionsBuilder().
+		Deck(NewStandardDeckCards()).
+		AddPlayer(NewDealer(10000)).
+		AddPlayer(NewSmallBlind(10000)).
+		AddPlayer(NewBigBlind(10000)).
+		AddPlayer(NewPlayer(10000)).
+		Build()
+	assert.Nil(t, err)
+
+	assert.Equal(t, []string{"dealer"}, opts.Players[0].Positions)
+	assert.Equal(t, []string{"sb"}, opts.Players[1].Positions)
+	assert.Equal(t, []string{"bb"}, opts.Players[2].Positions)
+	assert.Empty(t, opts.Players[3].Positions)
+	assert.Equal(t, int64(10000), opts.Players[3].Bankroll)
+
+	// Heads-up dealer posts small blind
+	assert.Equal(t, []string{"dealer", "sb"}, NewPlayer(10000, "dealer", "sb").Positions)
+
+	g := NewGame(opts)
+	assert.Nil(t, g.Start())
+	assert.Nil(t, g.ReadyForAll())
+	assert.Nil(t, g.PayBlinds())
+	assert.Equal(t, 0, g.Dealer().SeatIndex())
+	assert.Equal(t, int64(5), g.SmallBlind().State().Wager)
+	assert.Equal(t, int64(10), g.BigBlind().State().Wager)
+}
+
+func Test_GameOptions_Positions(t *testing.T) {
+
+	// Missing dealer
+	opts := newTestGameOptions(10000, 10000, 10000)
+	opts.Players[0].Positions = []string{}
+	assert.Equal(t, ErrInvalidPositions, opts.Validate())
+
+	// Two dealers
+	opts = newTestGameOptions(10000, 10000, 10000, 10000)
+	opts.Players[3].Positions = []string{"dealer"}
+	assert.Equal(t, ErrInvalidPositions, opts.Validate())
+
+	// Two big blinds
+	opts = newTestGameOptions(10000, 10000, 10000, 10000)
+	opts.Players[3].Positions = []string{"bb"}
+	assert.Equal(t, ErrInvalidPositions, opts.Validate())
+
+	g := NewGame(opts)
+	assert.Equal(t, ErrInvalidPositions, g.Start())
+
+	// Heads-up dealer is small blind as well
+	opts = newTestGameOptions(10000, 10000)
+	opts.Players[0].Positions = []string{"dealer", "sb"}
+	opts.Players[1].Positions = []string{"bb"}
+	assert.Nil(t, opts.Validate())
+}
@@ -1,6 +1,13 @@
 package pokerlib
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
 	"github.com/d-protocol/pokerlib/combination"
 	"github.com/d-protocol/pokerlib/pot"
 	"github.com/d-protocol/pokerlib/settlement"
@@ -17,20 +24,36 @@ type GameState struct {
 }
 
 type Meta struct {
+	GameType               string                    `json:"game_type,omitempty"`
 	Ante                   int64                     `json:"ante"`
+	LiveAnte               bool                      `json:"live_ante,omitempty"`
 	Blind                  BlindSetting              `json:"blind"`
 	Limit                  string                    `json:"limit"`
+	LimitSetting           LimitSetting              `json:"limit_setting"`
 	HoleCardsCount         int                       `json:"hole_cards_count"`
 	RequiredHoleCardsCount int                       `json:"required_hole_cards_count"`
 	CombinationPowers      combination.PowerRankings `json:"combination_powers"`
 	Deck                   []string                  `json:"deck"`
 	BurnCount              int                       `json:"burn_count"`
+	DealOneAtATime         bool                      `json:"deal_one_at_a_time,omitempty"`
+	RunCount               int                       `json:"run_count,omitempty"`
+	MaxRaisesPerStreet     int                       `json:"max_raises_per_street,omitempty"`
+	MinChipUnit            int64                     `json:"min_chip_unit,omitempty"`
+	BoardLayout            []int                     `json:"board_layout,omitempty"`
+	DisabledActions        map[string][]string       `json:"disabled_actions,omitempty"`
+	FirstToActRule         string                    `json:"first_to_act_rule,omitempty"`
+	MaxSeats               int                       `json:"max_seats,omitempty"`
+	ShuffleSeed            int64                     `json:"shuffle_seed,omitempty"`
+	DeckFingerprint        string                    `json:"deck_fingerprint,omitempty"` // recorded if deck was not shuffled by seed
+	KillPot                KillPotSetting            `json:"kill_pot"`
+	Rake                   RakeSetting               `json:"rake"`
 }
 
 type Action struct {
-	Source int    `json:"source"`
-	Type   string `json:"type"`
-	Value  int64  `json:"value,omitempty"`
+	Source   int     `json:"source"`
+	Type     string  `json:"type"`
+	Value    int64   `json:"value,omitempty"`
+	Fraction float64 `json:"fraction,omitempty"`
 }
 
 type Status struct {
@@ -40,7 +63,11 @@ type Status struct {
 	Round               string     `json:"round,omitempty"`
 	Burned              []string   `json:"burned,omitempty"`
 	Board               []string   `json:"board,omitempty"`
+	Runout              bool       `json:"runout,omitempty"`
+	RunoutStreet        int        `json:"runout_street,omitempty"`
+	Runs                [][]string `json:"runs,omitempty"`
 	PreviousRaiseSize   int64      `json:"previous_raise_size"`
+	RaiseCount          int        `json:"raise_count,omitempty"`
 	CurrentDeckPosition int        `json:"current_deck_position"`
 	CurrentRoundPot     int64      `json:"current_round_pot"`
 	CurrentWager        int64      `json:"current_wager"`
@@ -48,17 +75,27 @@ type Status struct {
 	CurrentPlayer       int        `json:"current_player"`
 	CurrentEvent        string     `json:"current_event"`
 	LastAction          *Action    `json:"last_action,omitempty"`
+	ActionHistory       []*Action  `json:"action_history,omitempty"`
 }
 
 type PlayerState struct {
 	Idx       int      `json:"idx"`
+	PlayerID  string   `json:"player_id,omitempty"`
 	Positions []string `json:"positions"`
 
 	// Status
 	Acted          bool     `json:"acted"`
+	RaiseClosed    bool     `json:"raise_closed,omitempty"` // acted before a short all-in
 	DidAction      string   `json:"did_action,omitempty"`
 	Fold           bool     `json:"fold"`
 	VPIP           bool     `json:"vpip"` // Voluntarily Put In Pot
+	SitOut         bool     `json:"sit_out"`
+	Empty          bool     `json:"empty,omitempty"`  // seat without player, which is always skipped
+	Killed         bool     `json:"killed,omitempty"` // player who posts kill blind
+	DeadHand       bool     `json:"dead_hand,omitempty"`
+	MustPostBlind  bool     `json:"must_post_blind,omitempty"`
+	MissedBlind    int64    `json:"missed_blind,omitempty"`
+	TimeBank       int64    `json:"time_bank,omitempty"` // milliseconds
 	AllowedActions []string `json:"allowed_actions,omitempty"`
 
 	// Stack and wager
@@ -67,21 +104,68 @@ type PlayerState struct {
 	StackSize        int64 `json:"stack_size"`         // initial_stack_size - wager
 	Pot              int64 `json:"pot"`
 	Wager            int64 `json:"wager"`
+	UncalledBet      int64 `json:"uncalled_bet,omitempty"` // returned to player before settlement
+	DeadBlind        int64 `json:"dead_blind,omitempty"`   // posted to pot rather than wager
+	CashOut          int64 `json:"cash_out,omitempty"`     // paid to player by equity before settlement
+
+	// Fraction of winnings which were sold by cashing out
+	CashOutFraction float64 `json:"cash_out_fraction,omitempty"`
+
+	// Voluntary actions in this hand
+	ActionCounts ActionCounts `json:"action_counts"`
 
 	// Hole cards information
 	HoleCards   []string         `json:"hole_cards,omitempty"`
+	ShownCards  []int            `json:"shown_cards,omitempty"` // indexes of hole cards revealed to others
 	Combination *CombinationInfo `json:"combination,omitempty"`
 }
 
+// shownHoleCards returns hole cards which were revealed to others by ShowCard.
+func (ps *PlayerState) shownHoleCards() []string {
+
+	cards := make([]string, 0, len(ps.ShownCards))
+	for _, i := range ps.ShownCards {
+		if i >= 0 && i < len(ps.HoleCards) {
+			cards = append(cards, ps.HoleCards[i])
+		}
+	}
+
+	return cards
+}
+
+// ActionCounts is the number of voluntary actions which player took in the hand, an all-in is
+// counted as what it amounts to.
+type ActionCounts struct {
+	Bets   int `json:"bets"`
+	Raises int `json:"raises"`
+	Calls  int `json:"calls"`
+}
+
 type CombinationInfo struct {
 	Type  string   `json:"type"`
 	Cards []string `json:"cards"`
 	Power int      `json:"power"`
 }
 
+func (ci *CombinationInfo) equal(other *CombinationInfo) bool {
+
+	if ci.Type != other.Type || ci.Power != other.Power || len(ci.Cards) != len(other.Cards) {
+		return false
+	}
+
+	for i, c := range ci.Cards {
+		if c != other.Cards[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
 func (gs *GameState) AsPlayer(idx int) {
 
 	gs.Meta.Deck = []string{}
+	gs.Meta.ShuffleSeed = 0
 	gs.Status.Burned = []string{}
 
 	// Do nothing if game has been closed already
@@ -94,7 +178,7 @@ func (gs *GameState) AsPlayer(idx int) {
 
 			// Hide private information if player do fold
 			if p.Fold {
-				p.HoleCards = []string{}
+				p.HoleCards = p.shownHoleCards()
 				p.Combination = nil
 			}
 		}
@@ -108,7 +192,7 @@ func (gs *GameState) AsPlayer(idx int) {
 		}
 
 		// Hide private information
-		p.HoleCards = []string{}
+		p.HoleCards = p.shownHoleCards()
 		p.Combination = nil
 	}
 }
@@ -116,6 +200,7 @@ func (gs *GameState) AsPlayer(idx int) {
 func (gs *GameState) AsObserver() {
 
 	gs.Meta.Deck = []string{}
+	gs.Meta.ShuffleSeed = 0
 	gs.Status.Burned = []string{}
 
 	if gs.Status.CurrentEvent == "GameClosed" {
@@ -124,7 +209,7 @@ func (gs *GameState) AsObserver() {
 
 			// Hide private information if player do fold
 			if p.Fold {
-				p.HoleCards = []string{}
+				p.HoleCards = p.shownHoleCards()
 				p.Combination = nil
 			}
 		}
@@ -134,11 +219,57 @@ func (gs *GameState) AsObserver() {
 
 	// Hide all private information
 	for _, p := range gs.Players {
-		p.HoleCards = []string{}
+		p.HoleCards = p.shownHoleCards()
 		p.Combination = nil
 	}
 }
 
+// boardLayout returns the number of board cards dealt on each street after preflop.
+func (gs *GameState) boardLayout() []int {
+
+	if len(gs.Meta.BoardLayout) == 0 {
+		return DefaultBoardLayout
+	}
+
+	return gs.Meta.BoardLayout
+}
+
+// streetRound returns the round of a street after preflop, the first street is flop, the last one is
+// river and the others are turn.
+func (gs *GameState) streetRound(street int) string {
+
+	switch {
+	case street == 0:
+		return "flop"
+	case street >= len(gs.boardLayout())-1:
+		return "river"
+	}
+
+	return "turn"
+}
+
+// streetCards returns board cards which were dealt on a street after preflop, or nil if the street
+// was not dealt yet.
+func (gs *GameState) streetCards(street int) []string {
+
+	layout := gs.boardLayout()
+	if street < 0 || street >= len(layout) {
+		return nil
+	}
+
+	start := 0
+	for _, count := range layout[:street] {
+		start += count
+	}
+
+	end := start + layout[street]
+	if len(gs.Status.Board) < end {
+		return nil
+	}
+
+	return gs.Status.Board[start:end]
+}
+
 func (gs *GameState) GetPlayer(idx int) *PlayerState {
 
 	if idx < 0 || idx >= len(gs.Players) {
@@ -180,6 +311,11 @@ func (gs *GameState) HasAction(idx int, action string) bool {
 	return false
 }
 
+// isDealtOut returns true if seat is empty or player has no chips to play this game.
+func (ps *PlayerState) isDealtOut() bool {
+	return ps.Empty || ps.Bankroll <= 0
+}
+
 func (ps *PlayerState) AllowAction(action string) {
 
 	for _, aa := range ps.AllowedActions {
@@ -190,3 +326,176 @@ func (ps *PlayerState) AllowAction(action string) {
 
 	ps.AllowedActions = append(ps.AllowedActions, action)
 }
+
+// Clone returns a deep copy of state which shares no slices or maps with the original one.
+func (gs *GameState) Clone() *GameState {
+
+	data, err := json.Marshal(gs)
+	if err != nil {
+		return nil
+	}
+
+	var state GameState
+	err = json.Unmarshal(data, &state)
+	if err != nil {
+		return nil
+	}
+
+	return &state
+}
+
+// Checksum returns a stable hash over the meaningful fields of the state. Timestamps are
+// excluded because they are updated on every break point.
+func (gs *GameState) Checksum() string {
+
+	state := *gs
+	state.CreatedAt = 0
+	state.UpdatedAt = 0
+
+	data, err := json.Marshal(&state)
+	if err != nil {
+		return ""
+	}
+
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:])
+}
+
+// VerifyChecksum reports whether the checksum of the state matches the expected one, so that a state
+// which was tampered with is detected.
+func (gs *GameState) VerifyChecksum(expected string) bool {
+	return gs.Checksum() == expected
+}
+
+type CompareOptions struct {
+	CompareTimestamps bool     // CreatedAt and UpdatedAt are ignored by default
+	IgnoreFields      []string // JSON paths to be ignored (e.g., "status.burned", "players.0.hole_cards")
+}
+
+// StatesEqual returns true if both states are the same with options.
+func StatesEqual(a, b *GameState, opts CompareOptions) bool {
+	return DiffStates(a, b, opts) == ""
+}
+
+// DiffStates returns the JSON path of the first field that differs between states, or an empty
+// string if they are the same. Fields are visited in order of JSON keys to be deterministic.
+func DiffStates(a, b *GameState, opts CompareOptions) string {
+
+	if a == nil || b == nil {
+		if a == b {
+			return ""
+		}
+
+		return "."
+	}
+
+	va, err := normalizeState(a, opts)
+	if err != nil {
+		return "."
+	}
+
+	vb, err := normalizeState(b, opts)
+	if err != nil {
+		return "."
+	}
+
+	ignored := make(map[string]bool, len(opts.IgnoreFields))
+	for _, f := range opts.IgnoreFields {
+		ignored[f] = true
+	}
+
+	return diffValues("", va, vb, ignored)
+}
+
+func normalizeState(gs *GameState, opts CompareOptions) (interface{}, error) {
+
+	state := *gs
+	if !opts.CompareTimestamps {
+		state.CreatedAt = 0
+		state.UpdatedAt = 0
+	}
+
+	data, err := json.Marshal(&state)
+	if err != nil {
+		return nil, err
+	}
+
+	var v interface{}
+	err = json.Unmarshal(data, &v)
+	if err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+func diffValues(path string, a, b interface{}, ignored map[string]bool) string {
+
+	if ignored[path] {
+		return ""
+	}
+
+	joinPath := func(key string) string {
+		if len(path) == 0 {
+			return key
+		}
+
+		return path + "." + key
+	}
+
+	switch va := a.(type) {
+	case map[string]interface{}:
+
+		vb, ok := b.(map[string]interface{})
+		if !ok {
+			return path
+		}
+
+		keys := make([]string, 0, len(va)+len(vb))
+		for k := range va {
+			keys = append(keys, k)
+		}
+
+		for k := range vb {
+			if _, ok := va[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			if d := diffValues(joinPath(k), va[k], vb[k], ignored); d != "" {
+				return d
+			}
+		}
+
+		return ""
+
+	case []interface{}:
+
+		vb, ok := b.([]interface{})
+		if !ok {
+			return path
+		}
+
+		for i := 0; i < len(va) && i < len(vb); i++ {
+			if d := diffValues(joinPath(fmt.Sprint(i)), va[i], vb[i], ignored); d != "" {
+				return d
+			}
+		}
+
+		if len(va) != len(vb) {
+			return path
+		}
+
+		return ""
+	}
+
+	if !reflect.DeepEqual(a, b) {
+		return path
+	}
+
+	return ""
+}
@@ -0,0 +1,185 @@
+package pokerlib
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestGameOptions(bankrolls ...int64) *GameOptions {
+
+	opts := NewStandardGameOptions()
+	opts.Deck = NewStandardDeckCards()
+
+	positions := [][]string{
+		{"dealer"},
+		{"sb"},
+		{"bb"},
+	}
+
+	for i, bankroll := range bankrolls {
+
+		ps := &PlayerSetting{
+			Bankroll:  bankroll,
+			Positions: []string{},
+		}
+
+		if i < len(positions) {
+			ps.Positions = positions[i]
+		}
+
+		opts.Players = append(opts.Players, ps)
+	}
+
+	return opts
+}
+
+func Test_GameState_Checksum(t *testing.T) {
+
+	g := NewGame(newTestGameOptions(10000, 10000, 10000))
+	assert.Nil(t, g.Start())
+	assert.Nil(t, g.ReadyForAll())
+	assert.Nil(t, g.PayBlinds())
+
+	gs := g.GetState()
+	checksum := gs.Checksum()
+	assert.NotEmpty(t, checksum)
+	assert.True(t, gs.VerifyChecksum(checksum))
+
+	// Timestamps should not affect checksum
+	gs.UpdatedAt++
+	assert.Equal(t, checksum, gs.Checksum())
+
+	// Re-serialization should not affect checksum
+	data, err := json.Marshal(gs)
+	assert.Nil(t, err)
+
+	var restored GameState
+	assert.Nil(t, json.Unmarshal(data, &restored))
+	assert.Equal(t, checksum, restored.Checksum())
+
+	// Mutating wager should change checksum
+	restored.Players[0].Wager += 10
+	assert.NotEqual(t, checksum, restored.Checksum())
+	assert.False(t, restored.VerifyChecksum(checksum))
+}
+
+func Test_GameState_StatesEqual(t *testing.T) {
+
+	g := NewGame(newTestGameOptions(10000, 10000, 10000))
+	assert.Nil(t, g.Start())
+	assert.Nil(t, g.ReadyForAll())
+	assert.Nil(t, g.PayBlinds())
+
+	a := g.GetState()
+
+	var b GameState
+	data, err := json.Marshal(a)
+	assert.Nil(t, err)
+	assert.Nil(t, json.Unmarshal(data, &b))
+
+	// Timestamps are ignored by default
+	b.CreatedAt++
+	b.UpdatedAt++
+	assert.True(t, StatesEqual(a, &b, CompareOptions{}))
+	assert.Equal(t, "", DiffStates(a, &b, CompareOptions{}))
+	assert.False(t, StatesEqual(a, &b, CompareOptions{CompareTimestamps: true}))
+	assert.Equal(t, "created_at", DiffStates(a, &b, CompareOptions{CompareTimestamps: true}))
+
+	// Wager is different
+	b.Players[2].Wager++
+	assert.False(t, StatesEqual(a, &b, CompareOptions{}))
+	assert.Equal(t, "players.2.wager", DiffStates(a, &b, CompareOptions{}))
+	assert.True(t, StatesEqual(a, &b, CompareOptions{IgnoreFields: []string{"players.2.wager"}}))
+}
+
+func Test_Game_Clone(t *testing.T) {
+
+	g := NewGame(newTestGameOptions(10000, 10000, 10000))
+	assert.Nil(t, g.Start())
+	assert.Nil(t, g.ReadyForAll())
+	assert.Nil(t, g.PayBlinds())
+	assert.Nil(t, g.ReadyForAll())
+
+	checksum := g.GetState().Checksum()
+	cp := g.GetState().Status.CurrentPlayer
+
+	c := g.Clone()
+	assert.True(t, StatesEqual(g.GetState(), c.GetState(), CompareOptions{}))
+
+	// Raise on the clone should not affect the original game
+	assert.Nil(t, c.Raise(30))
+	assert.Equal(t, int64(30), c.GetState().Players[cp].Wager)
+	assert.NotEqual(t, cp, c.GetState().Status.CurrentPlayer)
+
+	assert.Equal(t, checksum, g.GetState().Checksum())
+	assert.Equal(t, cp, g.GetState().Status.CurrentPlayer)
+	assert.Equal(t, int64(0), g.GetState().Players[cp].Wager)
+
+	// Original game is still playable
+	assert.Nil(t, g.Call())
+	assert.Equal(t, int64(30), c.GetState().Players[cp].Wager)
+}
+
+func Test_Game_NewHand(t *testing.T) {
+
+	g := startTestGame(t, newTestGameOptions(10000, 10000, 10000))
+
+	// Hand is in progress
+	positions := map[int][]string{
+		0: {"bb"},
+		1: {"dealer"},
+		2: {"sb"},
+	}
+	assert.Equal(t, ErrHandNotComplete, g.NewHand(nil, positions))
+
+	// The first hand: dealer takes blinds
+	assert.Nil(t, g.Raise(50))
+	assert.Nil(t, g.Fold())
+	assert.Nil(t, g.Fold())
+	assert.True(t, g.IsHandComplete())
+
+	assert.Equal(t, ErrInvalidPositions, g.NewHand(nil, map[int][]string{0: {"sb"}}))
+	assert.Nil(t, g.NewHand(NewStandardDeckCards(), positions))
+
+	// Bankrolls are carried over and states of the last hand are gone
+	gs := g.GetState()
+	assert.Equal(t, int64(10015), gs.GetPlayer(0).Bankroll)
+	assert.Equal(t, int64(9995), gs.GetPlayer(1).Bankroll)
+	assert.Equal(t, int64(9990), gs.GetPlayer(2).Bankroll)
+	assert.Nil(t, gs.Result)
+	assert.Empty(t, gs.Status.ActionHistory)
+	assert.Equal(t, 1, g.Dealer().SeatIndex())
+	assert.Equal(t, 2, g.SmallBlind().SeatIndex())
+	assert.Equal(t, 0, g.BigBlind().SeatIndex())
+
+	// The second hand
+	assert.Nil(t, g.Start())
+	assert.Nil(t, g.ReadyForAll())
+	assert.Nil(t, g.PayBlinds())
+	assert.Nil(t, g.ReadyForAll())
+
+	gs = g.GetState()
+	assert.Equal(t, "preflop", gs.Status.Round)
+	assert.Empty(t, gs.Status.Board)
+	assert.Equal(t, int64(10), gs.GetPlayer(0).Wager)
+	assert.Equal(t, int64(5), gs.GetPlayer(2).Wager)
+	assert.Len(t, gs.GetPlayer(1).HoleCards, 2)
+
+	assert.Equal(t, 1, g.GetCurrentPlayer().SeatIndex())
+	assert.Nil(t, g.Fold())
+	assert.Nil(t, g.Fold())
+	assert.True(t, g.IsHandComplete())
+
+	for _, r := range g.GetState().Result.Players {
+		switch r.Idx {
+		case 0:
+			assert.Equal(t, int64(10020), r.Final)
+		case 1:
+			assert.Equal(t, int64(9995), r.Final)
+		case 2:
+			assert.Equal(t, int64(9985), r.Final)
+		}
+	}
+}
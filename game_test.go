@@ -215,7 +215,7 @@ func TestManualGame(t *testing.T) {
 	}
 
 	// Game should be completed
-	if game.GetState().Status.CurrentEvent != "GameClosed" && game.GetState().Status.CurrentEvent != "SettlementCompleted" {
+	if game.GetState().Status.CurrentEvent != "GameClosed" {
 		t.Fatalf("Game didn't complete properly, current event: %s", game.GetState().Status.CurrentEvent)
 	}
 
@@ -0,0 +1,383 @@
+package pokerlib
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	ErrGameNotCompleted = errors.New("game: game is not completed")
+)
+
+var handHistoryStreets = map[string]string{
+	"preflop": "Preflop",
+	"flop":    "Flop",
+	"turn":    "Turn",
+	"river":   "River",
+}
+
+var handHistoryCombinations = map[string]string{
+	"HighCard":      "high card",
+	"Pair":          "a pair",
+	"TwoPair":       "two pair",
+	"ThreeOfAKind":  "three of a kind",
+	"Straight":      "a straight",
+	"Flush":         "a flush",
+	"FullHouse":     "a full house",
+	"FourOfAKind":   "four of a kind",
+	"StraightFlush": "a straight flush",
+}
+
+// handHistoryStreet returns the name of street by the number of streets dealt, which is preflop
+// before any board card was dealt.
+func handHistoryStreet(gs *GameState, street int) string {
+
+	if street == 0 {
+		return handHistoryStreets["preflop"]
+	}
+
+	return handHistoryStreets[gs.streetRound(street-1)]
+}
+
+// ExportHandHistory exports the game in the widely-used PokerStars-style hand history text format.
+func (g *game) ExportHandHistory() (string, error) {
+	return ExportHandHistory(g.gs)
+}
+
+// ExportHandHistory exports the completed game state in the widely-used PokerStars-style hand history
+// text format, which is derived from action history of the game.
+func ExportHandHistory(gs *GameState) (string, error) {
+
+	if gs.Result == nil {
+		return "", ErrGameNotCompleted
+	}
+
+	var sb strings.Builder
+
+	button := 0
+	for _, p := range gs.Players {
+		if gs.HasPosition(p.Idx, "dealer") {
+			button = p.Idx
+		}
+	}
+
+	// Header
+	fmt.Fprintf(&sb, "PokerStars Hand #%s: %s (%d/%d) - %s\n",
+		gs.GameID,
+		handHistoryGameName(gs),
+		gs.Meta.Blind.SB,
+		gs.Meta.Blind.BB,
+		time.Unix(gs.CreatedAt, 0).UTC().Format("2006/01/02 15:04:05 UTC"),
+	)
+	fmt.Fprintf(&sb, "Table '%s' %d-max Seat #%d is the button\n", gs.GameID, len(gs.Players), button+1)
+
+	for _, p := range gs.Players {
+		if p.isDealtOut() {
+			continue
+		}
+
+		fmt.Fprintf(&sb, "Seat %d: %s (%d in chips)\n", p.Idx+1, handHistoryPlayerName(p), p.Bankroll)
+	}
+
+	// Actions
+	street := 0
+	currentWager := int64(0)
+	wagers := make(map[int]int64)
+	folded := make(map[int]string)
+	holeCardsDealt := false
+
+	for _, a := range gs.Status.ActionHistory {
+
+		switch a.Type {
+		case "ready", "pass", "ante", "small_blind", "big_blind", "dealer_blind", "kill_blind", "posted_blind", "dead_blind", "bring_in":
+		default:
+
+			// Hole cards are dealt after forced bets
+			if !holeCardsDealt {
+				handHistoryHoleCards(&sb, gs)
+				holeCardsDealt = true
+			}
+		}
+
+		if a.Type == "next" {
+			street++
+			currentWager = 0
+			wagers = make(map[int]int64)
+
+			// Cards of the street are shown after board of previous streets
+			cards := gs.streetCards(street - 1)
+			if len(cards) == 0 {
+				continue
+			}
+
+			previous := 0
+			for _, count := range gs.boardLayout()[:street-1] {
+				previous += count
+			}
+
+			name := strings.ToUpper(handHistoryStreet(gs, street))
+			if previous == 0 {
+				fmt.Fprintf(&sb, "*** %s *** [%s]\n", name, handHistoryCards(cards))
+			} else {
+				fmt.Fprintf(&sb, "*** %s *** [%s] [%s]\n", name, handHistoryCards(gs.Status.Board[:previous]), handHistoryCards(cards))
+			}
+
+			continue
+		}
+
+		p := gs.GetPlayer(a.Source)
+		if p == nil {
+			continue
+		}
+
+		name := handHistoryPlayerName(p)
+
+		switch a.Type {
+		case "ante":
+			fmt.Fprintf(&sb, "%s: posts the ante %d\n", name, a.Value)
+		case "dead_blind":
+			fmt.Fprintf(&sb, "%s: posts dead blind %d\n", name, a.Value)
+		case "small_blind", "big_blind", "dealer_blind", "kill_blind", "posted_blind", "bring_in":
+
+			// Player who has no blinds to pay
+			if a.Value == 0 {
+				continue
+			}
+
+			wagers[p.Idx] += a.Value
+			if wagers[p.Idx] > currentWager {
+				currentWager = wagers[p.Idx]
+			}
+
+			switch a.Type {
+			case "small_blind":
+				fmt.Fprintf(&sb, "%s: posts small blind %d\n", name, a.Value)
+			case "big_blind":
+				fmt.Fprintf(&sb, "%s: posts big blind %d\n", name, a.Value)
+			case "dealer_blind":
+				fmt.Fprintf(&sb, "%s: posts dealer blind %d\n", name, a.Value)
+			case "kill_blind":
+				fmt.Fprintf(&sb, "%s: posts kill blind %d\n", name, a.Value)
+			case "posted_blind":
+				fmt.Fprintf(&sb, "%s: posts blind %d\n", name, a.Value)
+			case "bring_in":
+				fmt.Fprintf(&sb, "%s: brings in for %d\n", name, a.Value)
+			}
+		case "fold":
+			folded[p.Idx] = handHistoryStreet(gs, street)
+			fmt.Fprintf(&sb, "%s: folds\n", name)
+		case "dead_hand":
+			folded[p.Idx] = handHistoryStreet(gs, street)
+			fmt.Fprintf(&sb, "%s: hand is dead\n", name)
+		case "cash_out":
+			fmt.Fprintf(&sb, "%s: cashes out for %d\n", name, a.Value)
+		case "check":
+			fmt.Fprintf(&sb, "%s: checks\n", name)
+		case "call":
+			wagers[p.Idx] += a.Value
+			fmt.Fprintf(&sb, "%s: calls %d\n", name, a.Value)
+		case "bet":
+			wagers[p.Idx] += a.Value
+			currentWager = wagers[p.Idx]
+			fmt.Fprintf(&sb, "%s: bets %d\n", name, a.Value)
+		case "raise":
+			wagers[p.Idx] += a.Value
+			fmt.Fprintf(&sb, "%s: raises %d to %d\n", name, wagers[p.Idx]-currentWager, wagers[p.Idx])
+			currentWager = wagers[p.Idx]
+		case "complete":
+			wagers[p.Idx] += a.Value
+			currentWager = wagers[p.Idx]
+			fmt.Fprintf(&sb, "%s: completes to %d\n", name, wagers[p.Idx])
+		case "allin":
+
+			// Value of all-in is the total wager of this round
+			paid := a.Value - wagers[p.Idx]
+			wagers[p.Idx] = a.Value
+
+			if a.Value <= currentWager {
+				fmt.Fprintf(&sb, "%s: calls %d and is all-in\n", name, paid)
+			} else if currentWager == 0 {
+				fmt.Fprintf(&sb, "%s: bets %d and is all-in\n", name, paid)
+				currentWager = a.Value
+			} else {
+				fmt.Fprintf(&sb, "%s: raises %d to %d and is all-in\n", name, a.Value-currentWager, a.Value)
+				currentWager = a.Value
+			}
+		}
+	}
+
+	if !holeCardsDealt {
+		handHistoryHoleCards(&sb, gs)
+	}
+
+	// Uncalled bets
+	for _, p := range gs.Players {
+		if p.UncalledBet > 0 {
+			fmt.Fprintf(&sb, "Uncalled bet (%d) returned to %s\n", p.UncalledBet, handHistoryPlayerName(p))
+		}
+	}
+
+	// Show down
+	alivePlayers := make([]*PlayerState, 0)
+	for _, p := range gs.Players {
+		if !p.Fold {
+			alivePlayers = append(alivePlayers, p)
+		}
+	}
+
+	showdown := len(alivePlayers) > 1
+	if showdown {
+		sb.WriteString("*** SHOW DOWN ***\n")
+		for _, p := range alivePlayers {
+			fmt.Fprintf(&sb, "%s: shows [%s] (%s)\n", handHistoryPlayerName(p), handHistoryCards(p.HoleCards), handHistoryCombination(p))
+		}
+	}
+
+	// Winners
+	total := int64(0)
+	rake := int64(0)
+	won := make(map[int]int64)
+	for i, pot := range gs.Result.Pots {
+
+		total += pot.Total + pot.Rake
+		rake += pot.Rake
+
+		potName := "pot"
+		if len(gs.Result.Pots) > 1 {
+			if i == 0 {
+				potName = "main pot"
+			} else {
+				potName = fmt.Sprintf("side pot-%d", i)
+			}
+		}
+
+		for _, w := range pot.Winners {
+			won[w.Idx] += w.Withdraw
+			fmt.Fprintf(&sb, "%s collected %d from %s\n", handHistoryPlayerName(gs.GetPlayer(w.Idx)), w.Withdraw, potName)
+		}
+	}
+
+	// Summary
+	sb.WriteString("*** SUMMARY ***\n")
+	fmt.Fprintf(&sb, "Total pot %d | Rake %d\n", total, rake)
+
+	if len(gs.Status.Board) > 0 {
+		fmt.Fprintf(&sb, "Board [%s]\n", handHistoryCards(gs.Status.Board))
+	}
+
+	// Deck can be verified with either of them
+	if gs.Meta.ShuffleSeed != 0 {
+		fmt.Fprintf(&sb, "Shuffle seed %d\n", gs.Meta.ShuffleSeed)
+	} else if len(gs.Meta.DeckFingerprint) > 0 {
+		fmt.Fprintf(&sb, "Deck fingerprint %s\n", gs.Meta.DeckFingerprint)
+	}
+
+	for _, p := range gs.Players {
+
+		if p.isDealtOut() {
+			continue
+		}
+
+		fmt.Fprintf(&sb, "Seat %d: %s", p.Idx+1, handHistoryPlayerName(p))
+
+		if gs.HasPosition(p.Idx, "dealer") {
+			sb.WriteString(" (button)")
+		}
+
+		if gs.HasPosition(p.Idx, "sb") {
+			sb.WriteString(" (small blind)")
+		} else if gs.HasPosition(p.Idx, "bb") {
+			sb.WriteString(" (big blind)")
+		}
+
+		if street, ok := folded[p.Idx]; ok {
+			if street == "Preflop" {
+				sb.WriteString(" folded before Flop\n")
+			} else {
+				fmt.Fprintf(&sb, " folded on the %s\n", street)
+			}
+
+			continue
+		}
+
+		if !showdown {
+			fmt.Fprintf(&sb, " collected (%d)\n", won[p.Idx])
+			continue
+		}
+
+		if won[p.Idx] > 0 {
+			fmt.Fprintf(&sb, " showed [%s] and won (%d) with %s\n", handHistoryCards(p.HoleCards), won[p.Idx], handHistoryCombination(p))
+		} else {
+			fmt.Fprintf(&sb, " showed [%s] and lost with %s\n", handHistoryCards(p.HoleCards), handHistoryCombination(p))
+		}
+	}
+
+	return sb.String(), nil
+}
+
+func handHistoryGameName(gs *GameState) string {
+
+	name := "Hold'em"
+	if gs.Meta.HoleCardsCount == 4 {
+		name = "Omaha"
+	}
+
+	switch gs.Meta.Limit {
+	case "pot":
+		return name + " Pot Limit"
+	case "limit", "fixed":
+		return name + " Limit"
+	case "spread-limit":
+		return name + " Spread Limit"
+	}
+
+	return name + " No Limit"
+}
+
+func handHistoryPlayerName(p *PlayerState) string {
+
+	if len(p.PlayerID) > 0 {
+		return p.PlayerID
+	}
+
+	return fmt.Sprintf("Player%d", p.Idx+1)
+}
+
+func handHistoryHoleCards(sb *strings.Builder, gs *GameState) {
+
+	sb.WriteString("*** HOLE CARDS ***\n")
+
+	for _, p := range gs.Players {
+		if len(p.HoleCards) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(sb, "Dealt to %s [%s]\n", handHistoryPlayerName(p), handHistoryCards(p.HoleCards))
+	}
+}
+
+func handHistoryCombination(p *PlayerState) string {
+
+	if p.Combination == nil {
+		return ""
+	}
+
+	return handHistoryCombinations[p.Combination.Type]
+}
+
+// handHistoryCards converts cards to the format of hand history (e.g., "SA" to "As").
+func handHistoryCards(cards []string) string {
+
+	symbols := make([]string, 0, len(cards))
+	for _, c := range cards {
+		if len(c) < 2 {
+			continue
+		}
+
+		symbols = append(symbols, c[1:2]+strings.ToLower(c[0:1]))
+	}
+
+	return strings.Join(symbols, " ")
+}
@@ -0,0 +1,113 @@
+package pokerlib
+
+import (
+	"flag"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func newPresetDeck(cards ...string) []string {
+
+	deck := make([]string, 0)
+	deck = append(deck, cards...)
+
+	used := make(map[string]bool)
+	for _, c := range cards {
+		used[c] = true
+	}
+
+	for _, c := range NewStandardDeckCards() {
+		if !used[c] {
+			deck = append(deck, c)
+		}
+	}
+
+	return deck
+}
+
+func Test_HandHistory_ThreePlayers(t *testing.T) {
+
+	opts := newTestGameOptions(10000, 10000, 10000)
+	opts.Players[0].PlayerID = "alice"
+	opts.Players[1].PlayerID = "bob"
+	opts.Players[2].PlayerID = "carol"
+	opts.Deck = newPresetDeck(
+		"SA", "HA", // alice
+		"SK", "HK", // bob
+		"S2", "D7", // carol
+		"C3", "DA", "C9", "H4", // burn and flop
+		"C5", "S8", // burn and turn
+		"C6", "DT", // burn and river
+	)
+
+	g := NewGame(opts)
+	g.noShuffle = true
+	g.GetState().GameID = "1"
+	g.GetState().CreatedAt = 1700000000
+
+	_, err := g.ExportHandHistory()
+	assert.Equal(t, ErrGameNotCompleted, err)
+
+	assert.Nil(t, g.Start())
+	assert.Nil(t, g.ReadyForAll())
+	assert.Nil(t, g.PayBlinds())
+	assert.Nil(t, g.ReadyForAll())
+
+	// Preflop
+	assert.Nil(t, g.Raise(30))
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Fold())
+	assert.Nil(t, g.ReadyForAll())
+
+	// Flop
+	assert.Nil(t, g.Check())
+	assert.Nil(t, g.Pass())
+	assert.Nil(t, g.Bet(50))
+	assert.Nil(t, g.Raise(150))
+	assert.Nil(t, g.Pass())
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.ReadyForAll())
+
+	// Turn
+	assert.Nil(t, g.Check())
+	assert.Nil(t, g.Pass())
+	assert.Nil(t, g.Check())
+	assert.Nil(t, g.ReadyForAll())
+
+	// River
+	assert.Nil(t, g.Check())
+	assert.Nil(t, g.Pass())
+	assert.Nil(t, g.Check())
+	assert.Equal(t, "GameClosed", g.GetEvent())
+
+	hh, err := g.ExportHandHistory()
+	assert.Nil(t, err)
+
+	golden := "testdata/hand_history_three_players.golden"
+	if *update {
+		assert.Nil(t, os.WriteFile(golden, []byte(hh), 0644))
+	}
+
+	expected, err := os.ReadFile(golden)
+	assert.Nil(t, err)
+	assert.Equal(t, string(expected), hh)
+}
+
+func Test_HandHistory_ShuffleSeed(t *testing.T) {
+
+	opts := newTestGameOptions(10000, 10000, 10000)
+	opts.ShuffleSeed = 42
+
+	g := startTestGame(t, opts)
+	assert.Nil(t, g.Raise(30))
+	assert.Nil(t, g.Fold())
+	assert.Nil(t, g.Fold())
+
+	hh, err := g.ExportHandHistory()
+	assert.Nil(t, err)
+	assert.Contains(t, hh, "Shuffle seed 42\n")
+}
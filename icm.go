@@ -0,0 +1,98 @@
+package pokerlib
+
+import (
+	"errors"
+	"math/bits"
+)
+
+var (
+	ErrICMInvalidStack   = errors.New("icm: invalid stack")
+	ErrICMInvalidPayouts = errors.New("icm: invalid payouts")
+	ErrICMTooManyPlayers = errors.New("icm: too many players")
+)
+
+// icmMaxPlayers limits the number of players since states grow exponentially
+const icmMaxPlayers = 20
+
+// ICM returns the expected prize of each player by the Independent Chip Model, in which the chance
+// of a player to finish in the highest remaining place is proportional to stack size. Payouts are
+// prizes from the first place, places without payout are worth nothing.
+func ICM(stacks []int64, payouts []float64) ([]float64, error) {
+
+	n := len(stacks)
+	if n == 0 {
+		return nil, ErrICMInvalidStack
+	}
+
+	if n > icmMaxPlayers {
+		return nil, ErrICMTooManyPlayers
+	}
+
+	total := int64(0)
+	for _, s := range stacks {
+		if s < 0 {
+			return nil, ErrICMInvalidStack
+		}
+
+		total += s
+	}
+
+	if total == 0 {
+		return nil, ErrICMInvalidStack
+	}
+
+	for _, p := range payouts {
+		if p < 0 {
+			return nil, ErrICMInvalidPayouts
+		}
+	}
+
+	places := len(payouts)
+	if places > n {
+		places = n
+	}
+
+	// Probability that players of the set took the highest places in some order
+	probs := make([]float64, 1<<n)
+	probs[0] = 1
+
+	equities := make([]float64, n)
+	for finished := 0; finished < len(probs); finished++ {
+
+		p := probs[finished]
+		place := bits.OnesCount(uint(finished))
+		if p == 0 || place >= places {
+			continue
+		}
+
+		remaining := total
+		left := 0
+		for i, s := range stacks {
+			if finished&(1<<i) != 0 {
+				remaining -= s
+			} else {
+				left++
+			}
+		}
+
+		for i, s := range stacks {
+
+			if finished&(1<<i) != 0 {
+				continue
+			}
+
+			// Players without chips share the rest of places evenly
+			var q float64
+			if remaining > 0 {
+				q = p * float64(s) / float64(remaining)
+			} else {
+				q = p / float64(left)
+			}
+
+			equities[i] += q * payouts[place]
+			probs[finished|1<<i] += q
+		}
+	}
+
+	return equities, nil
+}
@@ -0,0 +1,67 @@
+package pokerlib
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestICM(t *testing.T) {
+
+	// Well-known result of 50/30/20 payouts
+	equities, err := ICM([]int64{5000, 3000, 2000}, []float64{0.5, 0.3, 0.2})
+	assert.Nil(t, err)
+	assert.InDelta(t, 0.3839, equities[0], 0.0001)
+	assert.InDelta(t, 0.3275, equities[1], 0.0001)
+	assert.InDelta(t, 0.2886, equities[2], 0.0001)
+
+	// Players with equal stacks share prizes evenly
+	equities, err = ICM([]int64{1000, 1000, 1000, 1000}, []float64{50, 30, 20})
+	assert.Nil(t, err)
+	for _, e := range equities {
+		assert.InDelta(t, 25, e, 0.0001)
+	}
+
+	// Busted player takes the last paid place
+	equities, err = ICM([]int64{1000, 1000, 0}, []float64{50, 30, 20})
+	assert.Nil(t, err)
+	assert.InDelta(t, 40, equities[0], 0.0001)
+	assert.InDelta(t, 20, equities[2], 0.0001)
+}
+
+func TestICM_FullTable(t *testing.T) {
+
+	stacks := []int64{1500, 3200, 800, 4100, 2700, 950, 6000, 1200, 2300, 500}
+	payouts := []float64{500, 300, 200, 100}
+
+	equities, err := ICM(stacks, payouts)
+	assert.Nil(t, err)
+	assert.Len(t, equities, len(stacks))
+
+	// Every prize is paid out and bigger stack is worth more
+	total := 0.0
+	for _, e := range equities {
+		total += e
+	}
+	assert.InDelta(t, 1100, total, 0.0001)
+	assert.Greater(t, equities[6], equities[3])
+	assert.Greater(t, equities[0], equities[2])
+}
+
+func TestICM_Invalid(t *testing.T) {
+
+	_, err := ICM([]int64{}, []float64{1})
+	assert.Equal(t, ErrICMInvalidStack, err)
+
+	_, err = ICM([]int64{100, -1}, []float64{1})
+	assert.Equal(t, ErrICMInvalidStack, err)
+
+	_, err = ICM([]int64{0, 0}, []float64{1})
+	assert.Equal(t, ErrICMInvalidStack, err)
+
+	_, err = ICM([]int64{100, 100}, []float64{1, -1})
+	assert.Equal(t, ErrICMInvalidPayouts, err)
+
+	_, err = ICM(make([]int64, 21), []float64{1})
+	assert.Equal(t, ErrICMTooManyPlayers, err)
+}
@@ -2,11 +2,17 @@ package pokerlib
 
 import (
 	"errors"
+	"fmt"
 )
 
 var (
-	ErrInvalidAction = errors.New("player: invalid action")
-	ErrIllegalRaise  = errors.New("player: illegal raise")
+	ErrInvalidAction     = errors.New("player: invalid action")
+	ErrActionNotAllowed  = fmt.Errorf("%w: action is not allowed", ErrInvalidAction)
+	ErrIllegalBet        = errors.New("player: illegal bet")
+	ErrIllegalRaise      = errors.New("player: illegal raise")
+	ErrInsufficientChips = errors.New("player: insufficient chips")
+	ErrChipUnitViolation = errors.New("player: chips is not a multiple of minimum chip unit")
+	ErrSpreadViolation   = errors.New("player: chips is out of spread limit")
 )
 
 type Player interface {
@@ -14,6 +20,7 @@ type Player interface {
 	SeatIndex() int
 	CheckAction(action string) bool
 	CheckPosition(pos string) bool
+	Position() Position
 	AllowActions(actions []string) error
 	ResetAllowedActions() error
 	Reset() error
@@ -21,12 +28,14 @@ type Player interface {
 	Pay(chips int64) error
 	PayAnte() error
 	PayBlinds() error
+	PayForcedBet(chips int64) error
 	Fold() error
 	Check() error
 	Call() error
 	Allin() error
 	Bet(chips int64) error
 	Raise(chipLevel int64) error
+	Complete() error
 }
 
 type player struct {
@@ -52,6 +61,7 @@ func (p *player) SeatIndex() int {
 
 func (p *player) Reset() error {
 	p.state.Acted = false
+	p.state.RaiseClosed = false
 	return p.ResetAllowedActions()
 }
 
@@ -89,6 +99,17 @@ func (p *player) CheckPosition(pos string) bool {
 	return false
 }
 
+// Position returns the canonical position of player which is computed from seat relative to the dealer.
+func (p *player) Position() Position {
+
+	dealer := p.game.Dealer()
+	if dealer == nil {
+		return PositionUnknown
+	}
+
+	return p.game.GetState().GetPosition(p.idx, dealer.SeatIndex())
+}
+
 func (p *player) CheckAction(action string) bool {
 
 	for _, aa := range p.state.AllowedActions {
@@ -103,7 +124,7 @@ func (p *player) CheckAction(action string) bool {
 func (p *player) Pass() error {
 
 	if !p.CheckAction("pass") {
-		return nil
+		return ErrActionNotAllowed
 	}
 
 	p.state.Acted = true
@@ -130,19 +151,25 @@ func (p *player) pay(chips int64, isWager bool) error {
 		p.state.Wager = p.state.InitialStackSize
 		p.state.StackSize = 0
 
-		if isWager {
+		// All-in for less than or equal to the current wager doesn't need anyone to respond
+		if isWager && p.state.InitialStackSize > gs.Status.CurrentWager {
 			raised := p.state.InitialStackSize - gs.Status.CurrentWager
-			minRaise := gs.Status.CurrentWager + gs.Status.PreviousRaiseSize
-
-			if p.state.InitialStackSize > gs.Status.CurrentWager {
-				gs.Status.CurrentWager = p.state.InitialStackSize
-			}
+			gs.Status.CurrentWager = p.state.InitialStackSize
 
-			if raised >= minRaise {
-				// Become new raiser
+			if raised >= gs.Status.PreviousRaiseSize {
+				// Full raise reopens betting
 				p.game.BecomeRaiser(p)
 			} else {
+				// Short all-in only requires others to respond to the extra chips, players who acted
+				// already are not able to raise again
+				for _, ps := range gs.Players {
+					if ps.Acted {
+						ps.RaiseClosed = true
+					}
+				}
+
 				p.game.ResetActedPlayers()
+				p.state.Acted = true
 			}
 		}
 
@@ -208,10 +235,13 @@ func (p *player) PayBlinds() error {
 		return ErrInvalidAction
 	}
 
-	// Pay for blinds
+	// Pay for blinds, player who killed the pot posts a kill blind as much as the doubled big blind
 	chips := int64(0)
 	action := "dealer_blind"
-	if gs.Meta.Blind.BB > 0 && p.CheckPosition("bb") {
+	if p.state.Killed && gs.Meta.KillPot.Threshold > 0 {
+		chips = gs.Meta.Blind.BB
+		action = "kill_blind"
+	} else if gs.Meta.Blind.BB > 0 && p.CheckPosition("bb") {
 		chips = gs.Meta.Blind.BB
 		action = "big_blind"
 	} else if gs.Meta.Blind.SB > 0 && p.CheckPosition("sb") {
@@ -220,6 +250,17 @@ func (p *player) PayBlinds() error {
 	} else if gs.Meta.Blind.Dealer > 0 && p.CheckPosition("dealer") {
 		chips = gs.Meta.Blind.Dealer
 		action = "dealer_blind"
+	} else if gs.Meta.Blind.BB > 0 && p.state.MustPostBlind && !p.state.SitOut {
+		chips = gs.Meta.Blind.BB
+		action = "posted_blind"
+	}
+
+	// Live ante is a part of blind
+	if gs.Meta.LiveAnte {
+		chips -= p.State().Wager
+		if chips < 0 {
+			chips = 0
+		}
 	}
 
 	if p.State().StackSize < chips {
@@ -231,15 +272,77 @@ func (p *player) PayBlinds() error {
 		return err
 	}
 
+	// Returning player is dealt in as usual once the blind is posted
+	if !p.state.SitOut {
+		p.state.MustPostBlind = false
+	}
+
 	p.game.UpdateLastAction(p.idx, action, chips)
 
+	// Missed blinds are posted dead after the live blind
+	if p.state.MissedBlind > 0 && !p.state.SitOut {
+		dead := p.postDeadBlind(p.state.MissedBlind)
+		p.state.MissedBlind = 0
+		p.game.UpdateLastAction(p.idx, "dead_blind", dead)
+	}
+
+	return nil
+}
+
+// postDeadBlind puts chips into pot directly rather than wager, so that chips are neither a part of
+// the live bet of player nor considered for calling and raising.
+func (p *player) postDeadBlind(chips int64) int64 {
+
+	if p.state.StackSize < chips {
+		chips = p.state.StackSize
+	}
+
+	p.state.Pot += chips
+	p.state.DeadBlind += chips
+	p.state.InitialStackSize -= chips
+	p.state.StackSize -= chips
+
+	if p.state.StackSize == 0 {
+		p.state.DidAction = "allin"
+	}
+
+	// Dead blind is still a part of pot of current round
+	gs := p.game.GetState()
+	gs.Status.CurrentRoundPot += chips
+
+	if gs.Meta.Limit == "pot" {
+		gs.Status.MaxWager = gs.Status.CurrentRoundPot + gs.Status.PreviousRaiseSize
+	}
+
+	return chips
+}
+
+func (p *player) PayForcedBet(chips int64) error {
+
+	gs := p.game.GetState()
+
+	if gs.Status.CurrentEvent != "BlindsRequested" {
+		return ErrInvalidAction
+	}
+
+	if p.State().StackSize < chips {
+		chips = p.State().StackSize
+	}
+
+	err := p.pay(chips, true)
+	if err != nil {
+		return err
+	}
+
+	p.game.UpdateLastAction(p.idx, "bring_in", chips)
+
 	return nil
 }
 
 func (p *player) Pay(chips int64) error {
 
 	if !p.CheckAction("pay") {
-		return ErrInvalidAction
+		return ErrActionNotAllowed
 	}
 
 	//fmt.Printf("[Player %d] Pay %d\n", p.idx, chips)
@@ -273,7 +376,7 @@ func (p *player) Pay(chips int64) error {
 func (p *player) Fold() error {
 
 	if !p.CheckAction("fold") {
-		return ErrInvalidAction
+		return ErrActionNotAllowed
 	}
 
 	p.state.Fold = true
@@ -288,21 +391,23 @@ func (p *player) Fold() error {
 
 func (p *player) Call() error {
 
+	// Player who cannot cover the current wager calls with all chips
+	gs := p.game.GetState()
+	if !p.CheckAction("call") && p.CheckAction("allin") && p.state.InitialStackSize <= gs.Status.CurrentWager {
+		return p.Allin()
+	}
+
 	if !p.CheckAction("call") {
-		return ErrInvalidAction
+		return ErrActionNotAllowed
 	}
 
 	//fmt.Printf("[Player %d] call\n", p.idx)
 
-	gs := p.game.GetState()
-
-	delta := gs.Status.CurrentWager - p.state.Wager
-	if gs.Status.CurrentWager < gs.Meta.Blind.BB {
-		delta = gs.Meta.Blind.BB - p.state.Wager
-	}
+	delta := p.game.CallAmount(p.idx)
 
 	p.state.DidAction = "call"
 	p.state.Acted = true
+	p.state.ActionCounts.Calls++
 
 	p.pay(delta, true)
 
@@ -314,7 +419,7 @@ func (p *player) Call() error {
 func (p *player) Check() error {
 
 	if !p.CheckAction("check") {
-		return ErrInvalidAction
+		return ErrActionNotAllowed
 	}
 
 	//fmt.Printf("[Player %d] check\n", p.idx)
@@ -330,13 +435,40 @@ func (p *player) Check() error {
 func (p *player) Bet(chips int64) error {
 
 	if !p.CheckAction("bet") {
-		return ErrInvalidAction
+		return ErrActionNotAllowed
+	}
+
+	if chips <= 0 {
+		return ErrIllegalBet
+	}
+
+	if chips > p.state.StackSize {
+		return ErrInsufficientChips
+	}
+
+	// Betting whole stack is exempted from minimum chip unit
+	if chips < p.state.StackSize && !isChipUnitMultiple(chips, p.game.GetState().Meta.MinChipUnit) {
+		return ErrChipUnitViolation
+	}
+
+	// Betting whole stack is exempted from minimum of spread
+	gs := p.game.GetState()
+	if chips > gs.Meta.LimitSetting.Max || chips < p.state.StackSize {
+		if !isWithinSpreadLimit(chips, gs.Meta.Limit, gs.Meta.LimitSetting) {
+			return ErrSpreadViolation
+		}
+	}
+
+	// Minimum bet is capped at stack, so betting whole stack is always allowed
+	if chips < p.game.MinBet() {
+		return ErrIllegalBet
 	}
 
 	//fmt.Printf("[Player %d] bet %d\n", p.idx, chips)
 
 	p.state.DidAction = "bet"
 	p.state.Acted = true
+	p.state.ActionCounts.Bets++
 
 	p.pay(chips, true)
 
@@ -347,10 +479,11 @@ func (p *player) Bet(chips int64) error {
 	return p.game.Resume()
 }
 
+// Raise raises the total wager of player in this round to chipLevel rather than raising by chipLevel.
 func (p *player) Raise(chipLevel int64) error {
 
 	if !p.CheckAction("raise") {
-		return ErrInvalidAction
+		return ErrActionNotAllowed
 	}
 
 	gs := p.game.GetState()
@@ -358,12 +491,28 @@ func (p *player) Raise(chipLevel int64) error {
 		return ErrIllegalRaise
 	}
 
+	if chipLevel > p.state.InitialStackSize {
+		return ErrInsufficientChips
+	}
+
 	if chipLevel == gs.Status.CurrentWager {
 		return p.Call()
 	}
 
-	// if chips is not enough to raise, player can do allin only
+	// Going all-in is exempted from minimum chip unit
+	if chipLevel < p.state.InitialStackSize && !isChipUnitMultiple(chipLevel, gs.Meta.MinChipUnit) {
+		return ErrChipUnitViolation
+	}
+
+	// Going all-in is exempted from minimum of spread
 	raised := chipLevel - gs.Status.CurrentWager
+	if raised > gs.Meta.LimitSetting.Max || chipLevel < p.state.InitialStackSize {
+		if !isWithinSpreadLimit(raised, gs.Meta.Limit, gs.Meta.LimitSetting) {
+			return ErrSpreadViolation
+		}
+	}
+
+	// if chips is not enough to raise, player can do allin only
 	required := chipLevel - p.state.Wager
 	//fmt.Println(gs.Status.PreviousRaiseSize)
 	//fmt.Printf(" %d => initial=%d, raised=%d, required=%d\n", chipLevel, p.state.InitialStackSize, raised, required)
@@ -384,9 +533,11 @@ func (p *player) Raise(chipLevel int64) error {
 
 	p.state.DidAction = "raise"
 	p.state.Acted = true
+	p.state.ActionCounts.Raises++
 
 	// Update raise size
 	gs.Status.PreviousRaiseSize = raised
+	gs.Status.RaiseCount++
 
 	p.pay(required, true)
 
@@ -395,10 +546,34 @@ func (p *player) Raise(chipLevel int64) error {
 	return p.game.Resume()
 }
 
+// Complete brings an incomplete bet (e.g., a short blind or bring-in) up to a full bet, which
+// reopens the action like a bet but is recorded distinctly from a raise.
+func (p *player) Complete() error {
+
+	if !p.CheckAction("complete") {
+		return ErrActionNotAllowed
+	}
+
+	gs := p.game.GetState()
+	required := gs.Status.MiniBet - p.state.Wager
+
+	p.state.DidAction = "complete"
+	p.state.Acted = true
+	p.state.ActionCounts.Bets++
+
+	gs.Status.PreviousRaiseSize = gs.Status.MiniBet
+
+	p.pay(required, true)
+
+	p.game.UpdateLastAction(p.idx, "complete", required)
+
+	return p.game.Resume()
+}
+
 func (p *player) Allin() error {
 
 	if !p.CheckAction("allin") {
-		return ErrInvalidAction
+		return ErrActionNotAllowed
 	}
 
 	//fmt.Printf("[Player %d] allin\n", p.idx)
@@ -409,9 +584,23 @@ func (p *player) Allin() error {
 	gs := p.game.GetState()
 	raised := p.state.InitialStackSize - gs.Status.CurrentWager
 
-	// Update previous raise size
-	if raised >= gs.Status.PreviousRaiseSize {
+	switch {
+	case raised <= 0:
+		p.state.ActionCounts.Calls++
+	case gs.Status.CurrentWager == 0:
+		p.state.ActionCounts.Bets++
+	default:
+		p.state.ActionCounts.Raises++
+	}
+
+	// Only a full raise updates previous raise size, a short all-in leaves it unchanged
+	if raised > 0 && raised >= gs.Status.PreviousRaiseSize {
 		gs.Status.PreviousRaiseSize = raised
+
+		// Full raise over the current wager
+		if gs.Status.CurrentWager > 0 {
+			gs.Status.RaiseCount++
+		}
 	}
 
 	p.pay(p.state.StackSize, true)
@@ -0,0 +1,557 @@
+package pokerlib
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Player_SitOut(t *testing.T) {
+
+	opts := newTestGameOptions(10000, 10000, 10000, 10000, 10000)
+	g := NewGame(opts)
+	assert.Nil(t, g.Start())
+	assert.Nil(t, g.ReadyForAll())
+	assert.Nil(t, g.PayBlinds())
+
+	// Big blind and the player in the middle of table are sitting out
+	g.Player(2).State().SitOut = true
+	g.Player(3).State().SitOut = true
+	assert.Equal(t, []string{"fold"}, g.GetAvailableActions(g.Player(3)))
+
+	assert.Nil(t, g.ReadyForAll())
+
+	// Player 3 was folded because of facing a bet
+	assert.True(t, g.Player(3).State().Fold)
+	assert.Equal(t, "fold", g.Player(3).State().DidAction)
+	assert.Equal(t, 4, g.GetCurrentPlayer().SeatIndex())
+
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Call())
+
+	// Big blind checked automatically so that round was closed
+	assert.False(t, g.Player(2).State().Fold)
+	assert.Equal(t, "flop", g.GetState().Status.Round)
+	assert.Nil(t, g.ReadyForAll())
+
+	// SB checks then big blind checks automatically
+	assert.Equal(t, 1, g.GetCurrentPlayer().SeatIndex())
+	assert.Nil(t, g.Check())
+	assert.Equal(t, "check", g.Player(2).State().DidAction)
+	assert.Equal(t, 3, g.GetCurrentPlayer().SeatIndex())
+	assert.Nil(t, g.Pass())
+	assert.Equal(t, 4, g.GetCurrentPlayer().SeatIndex())
+	assert.Nil(t, g.Bet(100))
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Call())
+
+	// Big blind is folded when facing a bet
+	assert.True(t, g.Player(2).State().Fold)
+	assert.Equal(t, 3, g.GetCurrentPlayer().SeatIndex())
+	assert.Nil(t, g.Pass())
+	assert.Equal(t, "turn", g.GetState().Status.Round)
+}
+
+func Test_Player_ForceFold(t *testing.T) {
+
+	g := startTestGame(t, newTestGameOptions(10000, 10000, 10000))
+	assert.Nil(t, g.Raise(30))
+
+	// Big blind is folded even if it is not his turn
+	assert.Equal(t, ErrNotFoundPlayer, g.ForceFold(5))
+	assert.Nil(t, g.ForceFold(2))
+	assert.True(t, g.Player(2).State().Fold)
+	assert.True(t, g.Player(2).State().SitOut)
+	assert.Equal(t, 1, g.GetCurrentPlayer().SeatIndex())
+
+	// Game is closed because only dealer is left
+	assert.Nil(t, g.ForceFold(1))
+	assert.Equal(t, "GameClosed", g.GetEvent())
+
+	// Next hand with the flags of previous hand
+	opts := newTestGameOptions(10000, 10000, 10000)
+	for i, ps := range g.GetState().Players {
+		opts.Players[i].SitOut = ps.SitOut
+	}
+
+	g = startTestGame(t, opts)
+	assert.Nil(t, g.Raise(30))
+
+	// Players who were force-folded are skipped automatically
+	assert.True(t, g.Player(1).State().Fold)
+	assert.True(t, g.Player(2).State().Fold)
+	assert.Equal(t, "GameClosed", g.GetEvent())
+}
+
+func Test_Player_ForceFold_OutOfTurn(t *testing.T) {
+
+	g := startTestGame(t, newTestGameOptions(10000, 10000, 10000, 10000))
+
+	// Dealer is folded while UTG is to act
+	assert.Equal(t, 3, g.GetCurrentPlayer().SeatIndex())
+	assert.Nil(t, g.ForceFold(0))
+	assert.Nil(t, g.Call())
+
+	// Dealer passes, then blinds still get to act
+	assert.Equal(t, 0, g.GetCurrentPlayer().SeatIndex())
+	assert.Nil(t, g.Pass())
+	assert.Equal(t, 1, g.GetCurrentPlayer().SeatIndex())
+	assert.Nil(t, g.Call())
+	assert.Equal(t, 2, g.GetCurrentPlayer().SeatIndex())
+	assert.Nil(t, g.Check())
+	assert.Equal(t, "flop", g.GetState().Status.Round)
+}
+
+func Test_Player_MarkDeadHand(t *testing.T) {
+
+	opts := newTestGameOptions(10000, 10000, 10000)
+	opts.PresetBoard = []string{"S2", "D7", "C9", "HJ", "D3"}
+	opts.Players[0].PresetHoleCards = []string{"SA", "HA"}
+	opts.Players[1].PresetHoleCards = []string{"SK", "HK"}
+	opts.Players[2].PresetHoleCards = []string{"C4", "H5"}
+
+	g := startTestGame(t, opts)
+	assert.ErrorIs(t, g.MarkDeadHand(5), ErrNotFoundPlayer)
+
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Check())
+	assert.Nil(t, g.ReadyForAll())
+
+	// Player who is not the current player has the best hand killed
+	assert.Equal(t, 1, g.GetCurrentPlayer().SeatIndex())
+	assert.Nil(t, g.MarkDeadHand(0))
+	assert.True(t, g.Player(0).State().DeadHand)
+	assert.True(t, g.Player(0).State().Fold)
+	assert.Equal(t, "dead_hand", g.GetState().Status.LastAction.Type)
+	assert.Equal(t, 1, g.GetCurrentPlayer().SeatIndex())
+
+	playToShowdown(t, g)
+
+	// Committed chips of dead hand stay in pot
+	for _, r := range g.GetState().Result.Players {
+		switch r.Idx {
+		case 0:
+			assert.Equal(t, int64(-10), r.Changed)
+		case 1:
+			assert.Equal(t, int64(20), r.Changed)
+		case 2:
+			assert.Equal(t, int64(-10), r.Changed)
+		}
+	}
+
+	history, err := g.ExportHandHistory()
+	assert.Nil(t, err)
+	assert.Contains(t, history, "hand is dead")
+}
+
+func Test_Player_MarkDeadHand_MidRound(t *testing.T) {
+
+	g := startTestGame(t, newTestGameOptions(10000, 10000, 10000, 10000))
+
+	// Hand of small blind is killed before small blind acted
+	assert.Equal(t, 3, g.GetCurrentPlayer().SeatIndex())
+	assert.Nil(t, g.MarkDeadHand(1))
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Call())
+
+	// Betting round goes on until big blind acted
+	assert.Equal(t, 1, g.GetCurrentPlayer().SeatIndex())
+	assert.Nil(t, g.Pass())
+	assert.Equal(t, 2, g.GetCurrentPlayer().SeatIndex())
+	assert.Equal(t, "preflop", g.GetState().Status.Round)
+	assert.Nil(t, g.Raise(30))
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Pass())
+	assert.Equal(t, "flop", g.GetState().Status.Round)
+}
+
+func Test_Player_MarkDeadHand_LastAlivePlayer(t *testing.T) {
+
+	g := startTestGame(t, newTestGameOptions(10000, 10000, 10000))
+	assert.Nil(t, g.Fold())
+
+	// The other player takes the pot once the hand is dead, uncalled part of big blind is returned
+	assert.Nil(t, g.MarkDeadHand(2))
+	assert.True(t, g.IsHandComplete())
+	assert.ErrorIs(t, g.MarkDeadHand(1), ErrHandComplete)
+	assert.Equal(t, int64(5), g.Player(2).State().UncalledBet)
+
+	for _, r := range g.GetState().Result.Players {
+		if r.Idx == 1 {
+			assert.Equal(t, int64(5), r.Changed)
+		}
+	}
+}
+
+func Test_Player_DeadHand_WrongHoleCards(t *testing.T) {
+
+	opts := newTestGameOptions(10000, 10000, 10000)
+	opts.PresetBoard = []string{"S2", "D7", "C9", "HJ", "D3"}
+	opts.Players[0].PresetHoleCards = []string{"SA", "HA"}
+	opts.Players[1].PresetHoleCards = []string{"SK", "HK"}
+	opts.Players[2].PresetHoleCards = []string{"C4", "H5"}
+
+	g := startTestGame(t, opts)
+
+	// Misdeal leaves player with a single hole card
+	ps := g.Player(0).State()
+	ps.HoleCards = ps.HoleCards[:1]
+
+	playToShowdown(t, g)
+
+	// Player with the best cards is not able to win the pot
+	assert.True(t, ps.DeadHand)
+	for _, r := range g.GetState().Result.Players {
+		if r.Idx == 1 {
+			assert.Equal(t, int64(20), r.Changed)
+			continue
+		}
+
+		assert.Equal(t, int64(-10), r.Changed)
+	}
+}
+
+func Test_Player_MustPostBlind(t *testing.T) {
+
+	opts := newTestGameOptions(10000, 10000, 10000, 10000, 10000)
+	opts.Players[3].MustPostBlind = true
+	opts.Players[4].MustPostBlind = true
+	opts.Players[4].SitOut = true
+
+	g := startTestGame(t, opts)
+
+	// Returning player posts a big blind, and player who is still sitting out doesn't
+	ps := g.Player(3).State()
+	assert.Equal(t, int64(10), ps.Wager)
+	assert.False(t, ps.MustPostBlind)
+	assert.Equal(t, int64(0), g.Player(4).State().Wager)
+	assert.True(t, g.Player(4).State().MustPostBlind)
+
+	// Posted blind is live, so that player is able to check
+	assert.Equal(t, 3, g.GetCurrentPlayer().SeatIndex())
+	assert.Contains(t, ps.AllowedActions, "check")
+	assert.Nil(t, g.Check())
+
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Check())
+
+	assert.Equal(t, "flop", g.GetState().Status.Round)
+	assert.Equal(t, int64(10), ps.Pot)
+	assert.Equal(t, int64(40), g.GetState().Status.Pots[0].Total)
+
+	// Everyone checks down to showdown
+	for !g.IsHandComplete() {
+		switch {
+		case g.GetEvent() == "ReadyRequested":
+			assert.Nil(t, g.ReadyForAll())
+		case g.GetCurrentPlayer().CheckAction("check"):
+			assert.Nil(t, g.Check())
+		default:
+			assert.Nil(t, g.Pass())
+		}
+	}
+
+	history, err := g.ExportHandHistory()
+	assert.Nil(t, err)
+	assert.Contains(t, history, "posts blind 10")
+}
+
+func Test_Player_DeadBlind(t *testing.T) {
+
+	opts := newTestGameOptions(10000, 10000, 10000, 10000)
+	opts.Players[3].MustPostBlind = true
+	opts.Players[3].MissedBlind = 5
+
+	g := startTestGame(t, opts)
+
+	// Returning player posts a live big blind and a dead small blind
+	ps := g.Player(3).State()
+	assert.Equal(t, int64(10), ps.Wager)
+	assert.Equal(t, int64(5), ps.Pot)
+	assert.Equal(t, int64(5), ps.DeadBlind)
+	assert.Equal(t, int64(0), ps.MissedBlind)
+	assert.Equal(t, int64(9985), ps.StackSize)
+
+	// Dead blind is in the pot but not in the wager to call
+	assert.Equal(t, int64(10), g.GetState().Status.CurrentWager)
+	assert.Equal(t, int64(30), g.GetState().Status.CurrentRoundPot)
+	assert.Equal(t, int64(10), g.CallAmount(0))
+
+	// Only the live blind counts, so that player checks without calling the dead chips
+	assert.Equal(t, 3, g.GetCurrentPlayer().SeatIndex())
+	assert.Equal(t, int64(0), g.CallAmount(3))
+	assert.Nil(t, g.Check())
+
+	// Raise to 30 is a full raise over the live blind
+	assert.Nil(t, g.Raise(30))
+	assert.Equal(t, int64(20), g.GetState().Status.PreviousRaiseSize)
+	assert.Nil(t, g.Fold())
+	assert.Nil(t, g.Fold())
+
+	// Returning player calls the raise by the live wager only
+	assert.Equal(t, int64(20), g.CallAmount(3))
+	assert.Nil(t, g.Call())
+
+	assert.Equal(t, "flop", g.GetState().Status.Round)
+	assert.Equal(t, int64(35), ps.Pot)
+	assert.Equal(t, int64(80), g.GetState().Status.Pots[0].Total)
+
+	playToShowdown(t, g)
+
+	// Dead blind is won along with the pot
+	changed := int64(0)
+	for _, r := range g.GetState().Result.Players {
+		changed += r.Changed
+	}
+	assert.Equal(t, int64(0), changed)
+
+	history, err := g.ExportHandHistory()
+	assert.Nil(t, err)
+	assert.Contains(t, history, "posts dead blind 5")
+}
+
+func Test_Player_EmptySeats(t *testing.T) {
+
+	opts := newTestGameOptions(10000, 10000, 10000, 10000, 10000, 10000)
+	opts.Players[2].Empty = true
+	opts.Players[4].Empty = true
+
+	// Blinds are moved to occupied seats
+	opts.Players[1].Positions = []string{"sb"}
+	opts.Players[2].Positions = []string{}
+	opts.Players[3].Positions = []string{"bb"}
+
+	g := startTestGame(t, opts)
+	gs := g.GetState()
+
+	assert.Empty(t, gs.Players[2].HoleCards)
+	assert.Empty(t, gs.Players[4].HoleCards)
+	assert.Equal(t, int64(5), gs.Players[1].Wager)
+	assert.Equal(t, int64(10), gs.Players[3].Wager)
+
+	// Preflop: UTG is the seat next to big blind which skips the empty seat
+	seats := make([]int, 0)
+	for gs.Status.Round == "preflop" {
+		seats = append(seats, gs.Status.CurrentPlayer)
+		if gs.HasAction(gs.Status.CurrentPlayer, "call") {
+			assert.Nil(t, g.Call())
+		} else {
+			assert.Nil(t, g.Check())
+		}
+	}
+	assert.Equal(t, []int{5, 0, 1, 3}, seats)
+
+	// Flop
+	assert.Nil(t, g.ReadyForAll())
+	seats = seats[:0]
+	for gs.Status.Round == "flop" {
+		seats = append(seats, gs.Status.CurrentPlayer)
+		assert.Nil(t, g.Check())
+	}
+	assert.Equal(t, []int{1, 3, 5, 0}, seats)
+
+	for _, idx := range []int{2, 4} {
+		assert.Zero(t, gs.Players[idx].Pot)
+		assert.True(t, gs.Players[idx].Fold)
+	}
+}
+
+func Test_Player_DeadButton(t *testing.T) {
+
+	// Player who holds the button has no chips
+	opts := newTestGameOptions(0, 10000, 10000, 10000)
+
+	g := startTestGame(t, opts)
+	gs := g.GetState()
+
+	assert.True(t, gs.Players[0].Fold)
+	assert.Empty(t, gs.Players[0].HoleCards)
+	assert.Equal(t, int64(5), gs.Players[1].Wager)
+	assert.Equal(t, int64(10), gs.Players[2].Wager)
+
+	// Preflop
+	seats := make([]int, 0)
+	for gs.Status.Round == "preflop" {
+		seats = append(seats, gs.Status.CurrentPlayer)
+		if gs.HasAction(gs.Status.CurrentPlayer, "call") {
+			assert.Nil(t, g.Call())
+		} else {
+			assert.Nil(t, g.Check())
+		}
+	}
+	assert.Equal(t, []int{3, 1, 2}, seats)
+
+	// Flop, turn and river
+	for g.GetEvent() != "GameClosed" {
+		assert.Nil(t, g.ReadyForAll())
+
+		seats = seats[:0]
+		for g.GetEvent() == "RoundStarted" {
+			seats = append(seats, gs.Status.CurrentPlayer)
+			assert.Nil(t, g.Check())
+		}
+		assert.Equal(t, []int{1, 2, 3}, seats)
+	}
+
+	assert.Equal(t, int64(0), gs.Result.Players[0].Final)
+	assert.Equal(t, int64(30), gs.Status.Pots[0].Total)
+
+	// Not enough players who have chips
+	g = NewGame(newTestGameOptions(0, 10000, 0))
+	assert.ErrorIs(t, g.Start(), ErrInsufficientNumberOfPlayers)
+}
+
+func Test_Player_Position(t *testing.T) {
+
+	bankrolls := make([]int64, 9)
+	for i := range bankrolls {
+		bankrolls[i] = 10000
+	}
+
+	// Dealer is at seat 3 on 9-max table
+	opts := newTestGameOptions(bankrolls...)
+	for _, p := range opts.Players {
+		p.Positions = []string{}
+	}
+	opts.Players[3].Positions = []string{"dealer"}
+	opts.Players[4].Positions = []string{"sb"}
+	opts.Players[5].Positions = []string{"bb"}
+
+	g := NewGame(opts)
+
+	expected := map[int]Position{
+		3: PositionDealer,
+		4: PositionSmallBlind,
+		5: PositionBigBlind,
+		6: PositionUTG,
+		7: PositionUTG1,
+		8: PositionUTG2,
+		0: PositionMiddle,
+		1: PositionHijack,
+		2: PositionCutoff,
+	}
+
+	for idx, pos := range expected {
+		assert.Equal(t, pos, g.Player(idx).Position(), idx)
+	}
+
+	assert.Equal(t, "co", g.Player(2).Position().String())
+
+	// Empty seats are skipped
+	opts.Players[7].Empty = true
+	opts.Players[1].Empty = true
+	g = NewGame(opts)
+	assert.Equal(t, PositionUTG, g.Player(6).Position())
+	assert.Equal(t, PositionUnknown, g.Player(7).Position())
+	assert.Equal(t, PositionMiddle, g.Player(8).Position())
+	assert.Equal(t, PositionHijack, g.Player(0).Position())
+	assert.Equal(t, PositionCutoff, g.Player(2).Position())
+
+	// Heads-up
+	g = NewGame(newTestGameOptions(10000, 10000))
+	assert.Equal(t, PositionDealer, g.Player(0).Position())
+	assert.Equal(t, PositionBigBlind, g.Player(1).Position())
+}
+
+func Test_Player_StackRanking(t *testing.T) {
+
+	opts := newTestGameOptions(5000, 20000, 10000, 20000, 0)
+	opts.Players[4].Empty = true
+	g := NewGame(opts)
+	assert.Nil(t, g.Start())
+
+	// Seat 1 and 3 are tied so the lower seat index comes first
+	assert.Equal(t, []int{1, 3, 2, 0}, g.StackRanking())
+	assert.Equal(t, 1, g.ChipLeader())
+
+	g.Player(3).State().StackSize++
+	assert.Equal(t, []int{3, 1, 2, 0}, g.StackRanking())
+	assert.Equal(t, 3, g.ChipLeader())
+}
+
+func Test_Player_NilSafety(t *testing.T) {
+
+	g := startTestGame(t, newTestGameOptions(10000, 10000, 10000))
+	assert.Nil(t, g.Player(-1))
+	assert.Nil(t, g.Player(3))
+	assert.Empty(t, g.GetAllowedActions(nil))
+	assert.ErrorIs(t, g.BecomeRaiser(nil), ErrNotFoundPlayer)
+
+	// Current player is out of range
+	g.GetState().Status.CurrentPlayer = 99
+	assert.Nil(t, g.GetCurrentPlayer())
+	assert.Nil(t, g.NextPlayer())
+	assert.ErrorIs(t, g.Check(), ErrNotCurrentPlayer)
+	assert.ErrorIs(t, g.RaiseTo(100), ErrNotCurrentPlayer)
+	assert.ErrorIs(t, g.AutoAct(0), ErrNotCurrentPlayer)
+
+	// Current player is cleared
+	assert.Nil(t, g.SetCurrentPlayer(nil))
+	assert.Equal(t, -1, g.GetState().Status.CurrentPlayer)
+	assert.ErrorIs(t, g.Call(), ErrNotCurrentPlayer)
+	assert.ErrorIs(t, g.Resume(), ErrNotCurrentPlayer)
+
+	// Engine keeps working once current player is restored
+	assert.Nil(t, g.SetCurrentPlayer(g.Player(0)))
+	assert.Nil(t, g.Call())
+}
+
+func Test_Player_MinPlayers(t *testing.T) {
+
+	// Two players are seated but only one of them has chips
+	opts := newTestGameOptions(10000, 0)
+	opts.Players[0].Positions = []string{"dealer", "sb"}
+	opts.Players[1].Positions = []string{"bb"}
+	g := NewGame(opts)
+	assert.ErrorIs(t, g.Start(), ErrInsufficientNumberOfPlayers)
+	assert.Equal(t, 2, g.GetPlayerCount())
+
+	opts = newTestGameOptions(10000, 10000)
+	opts.Players[0].Positions = []string{"dealer", "sb"}
+	opts.Players[1].Positions = []string{"bb"}
+	g = NewGame(opts)
+	assert.Nil(t, g.Start())
+}
+
+func Test_Player_ExtendPlayerTime(t *testing.T) {
+
+	opts := newTestGameOptions(10000, 10000, 10000)
+	opts.Players[0].TimeBank = 30000
+
+	g := startTestGame(t, opts)
+
+	assert.ErrorIs(t, g.ExtendPlayerTime(0, 0), ErrInvalidTimeExtension)
+	assert.ErrorIs(t, g.ExtendPlayerTime(5, 1000), ErrNotFoundPlayer)
+
+	// Extension is added to the balance and recorded as an action
+	assert.Nil(t, g.ExtendPlayerTime(0, 15000))
+	assert.Equal(t, int64(45000), g.Player(0).State().TimeBank)
+
+	gs := g.GetState()
+	assert.Equal(t, &Action{Source: 0, Type: "extend_time", Value: 15000}, gs.Status.LastAction)
+	assert.Equal(t, gs.Status.LastAction, gs.Status.ActionHistory[len(gs.Status.ActionHistory)-1])
+
+	// Player is still the one to act
+	assert.Equal(t, 0, g.GetCurrentPlayer().SeatIndex())
+	assert.Nil(t, g.Call())
+
+	assert.Nil(t, g.ExtendPlayerTime(1, 5000))
+	assert.Equal(t, int64(5000), g.Player(1).State().TimeBank)
+
+	playToShowdown(t, g)
+	assert.ErrorIs(t, g.ExtendPlayerTime(0, 1000), ErrHandComplete)
+
+	// Extensions are replayed
+	replayOpts := newTestGameOptions(10000, 10000, 10000)
+	replayOpts.Players[0].TimeBank = 30000
+	replayOpts.Deck = g.GetState().Meta.Deck
+
+	rg, err := ReplayGame(replayOpts, g.GetState().Status.ActionHistory)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(45000), rg.Player(0).State().TimeBank)
+	assert.Equal(t, g.GetState().Checksum(), rg.GetState().Checksum())
+}
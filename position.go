@@ -0,0 +1,99 @@
+package pokerlib
+
+type Position int32
+
+const (
+	PositionUnknown Position = iota
+	PositionDealer
+	PositionSmallBlind
+	PositionBigBlind
+	PositionUTG
+	PositionUTG1
+	PositionUTG2
+	PositionMiddle
+	PositionHijack
+	PositionCutoff
+)
+
+var PositionSymbols = map[Position]string{
+	PositionUnknown:    "unknown",
+	PositionDealer:     "dealer",
+	PositionSmallBlind: "sb",
+	PositionBigBlind:   "bb",
+	PositionUTG:        "utg",
+	PositionUTG1:       "utg1",
+	PositionUTG2:       "utg2",
+	PositionMiddle:     "mp",
+	PositionHijack:     "hj",
+	PositionCutoff:     "co",
+}
+
+func (pos Position) String() string {
+	return PositionSymbols[pos]
+}
+
+// Positions of seats between big blind and dealer. Late positions are taken first, then early
+// positions, and the rest of seats are middle positions.
+var (
+	earlyPositions = []Position{PositionUTG, PositionUTG1, PositionUTG2}
+	latePositions  = []Position{PositionMiddle, PositionHijack, PositionCutoff}
+)
+
+// GetPosition returns the canonical position of seat relative to the dealer. Seats which are empty
+// or have no chips are skipped, and dealer posts small blind in heads-up.
+func (gs *GameState) GetPosition(idx int, dealerIdx int) Position {
+
+	ps := gs.GetPlayer(idx)
+	if ps == nil || gs.GetPlayer(dealerIdx) == nil {
+		return PositionUnknown
+	}
+
+	if idx == dealerIdx {
+		return PositionDealer
+	}
+
+	if ps.isDealtOut() {
+		return PositionUnknown
+	}
+
+	// Players who are dealt in after the dealer
+	seats := make([]int, 0, len(gs.Players))
+	for i := 1; i < len(gs.Players); i++ {
+		seat := (dealerIdx + i) % len(gs.Players)
+		if !gs.Players[seat].isDealtOut() {
+			seats = append(seats, seat)
+		}
+	}
+
+	offset := 0
+	for i, seat := range seats {
+		if seat == idx {
+			offset = i
+		}
+	}
+
+	// Heads-up
+	if len(seats) == 1 && !gs.Players[dealerIdx].isDealtOut() {
+		return PositionBigBlind
+	}
+
+	switch offset {
+	case 0:
+		return PositionSmallBlind
+	case 1:
+		return PositionBigBlind
+	}
+
+	// Seats between big blind and dealer
+	count := len(seats) - 2
+	fromEnd := count - (offset - 2) - 1
+	if fromEnd < len(latePositions) {
+		return latePositions[len(latePositions)-1-fromEnd]
+	}
+
+	if offset-2 < len(earlyPositions) {
+		return earlyPositions[offset-2]
+	}
+
+	return PositionMiddle
+}
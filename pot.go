@@ -2,23 +2,209 @@ package pokerlib
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/d-protocol/pokerlib/pot"
 )
 
+// PotView is a summary of pot for display, EligibleSeats are players who are able to win it.
+type PotView struct {
+	Amount        int64 `json:"amount"`
+	Rake          int64 `json:"rake"` // taken from amount
+	EligibleSeats []int `json:"eligible_seats"`
+}
+
+// Pots returns main pot followed by side pots, which are calculated at the end of each betting round.
+// Wagers of the current round are not included.
+func (g *game) Pots() []PotView {
+
+	rakes := g.potRakes()
+
+	views := make([]PotView, 0, len(g.gs.Status.Pots))
+	for i, p := range g.gs.Status.Pots {
+
+		seats := make([]int, 0, len(p.Contributors))
+		for idx := range p.Contributors {
+
+			// Folded players contributed to pot but are not able to win it
+			if ps := g.gs.GetPlayer(idx); ps != nil && ps.Fold {
+				continue
+			}
+
+			seats = append(seats, idx)
+		}
+
+		sort.Ints(seats)
+
+		views = append(views, PotView{
+			Amount:        p.Total,
+			Rake:          rakes[i],
+			EligibleSeats: seats,
+		})
+	}
+
+	return views
+}
+
+// potRakes returns rake of each pot, cap is for the whole hand so that it is reached by main pot
+// first.
+func (g *game) potRakes() []int64 {
+
+	rake := g.gs.Meta.Rake
+	raked := int64(0)
+
+	rakes := make([]int64, 0, len(g.gs.Status.Pots))
+	for _, p := range g.gs.Status.Pots {
+
+		potRake := p.Total * rake.BasisPoints / 10000
+		if rake.Cap > 0 && raked+potRake > rake.Cap {
+			potRake = rake.Cap - raked
+		}
+
+		raked += potRake
+		rakes = append(rakes, potRake)
+	}
+
+	return rakes
+}
+
+// rakePot returns a copy of pot which rake was taken from, rake is taken from the lowest level first.
+func rakePot(p *pot.Pot, rake int64) *pot.Pot {
+
+	raked := &pot.Pot{
+		Level:        p.Level,
+		Wager:        p.Wager,
+		Total:        p.Total - rake,
+		Contributors: p.Contributors,
+		Levels:       make([]*pot.Level, 0, len(p.Levels)),
+	}
+
+	for _, l := range p.Levels {
+
+		taken := rake
+		if taken > l.Total {
+			taken = l.Total
+		}
+
+		rake -= taken
+
+		raked.Levels = append(raked.Levels, &pot.Level{
+			Level:        l.Level,
+			Wager:        l.Wager,
+			Total:        l.Total - taken,
+			Contributors: l.Contributors,
+		})
+	}
+
+	return raked
+}
+
+// AllInInfo is a player who went all-in, Committed is the total chips put into pot in this hand.
+type AllInInfo struct {
+	Idx       int   `json:"idx"`
+	Committed int64 `json:"committed"`
+}
+
+// AllInPlayers returns players who went all-in and did not fold, in order of committed chips from
+// the smallest, which is the order of side pots they are capped at.
+func (g *game) AllInPlayers() []AllInInfo {
+
+	players := make([]AllInInfo, 0)
+	for _, p := range g.gs.Players {
+		if p.isDealtOut() || p.Fold || p.StackSize > 0 {
+			continue
+		}
+
+		players = append(players, AllInInfo{
+			Idx:       p.Idx,
+			Committed: p.Pot + p.Wager,
+		})
+	}
+
+	sort.SliceStable(players, func(i, j int) bool {
+		return players[i].Committed < players[j].Committed
+	})
+
+	return players
+}
+
+// PotAfterRake returns total of pots net of rake, which is what winners are paid at settlement.
+func (g *game) PotAfterRake() int64 {
+
+	total := int64(0)
+	for _, p := range g.Pots() {
+		total += p.Amount - p.Rake
+	}
+
+	return total
+}
+
 func (g *game) updatePots() error {
 
 	ll := pot.NewLevelList()
 
+	// Dead blinds make no level, they go to the main pot
+	dead := int64(0)
 	for _, p := range g.gs.Players {
-		ll.AddContributor(p.Pot+p.Wager, p.Idx, p.Fold)
+		ll.AddContributor(p.Pot+p.Wager-p.DeadBlind, p.Idx, p.Fold)
+		dead += p.DeadBlind
 	}
 
 	g.gs.Status.Pots = ll.GetPots()
 
+	if dead > 0 && len(g.gs.Status.Pots) > 0 {
+		main := g.gs.Status.Pots[0]
+		main.Total += dead
+		main.Levels[0].Total += dead
+	}
+
 	return nil
 }
 
+// returnUncalledBet returns the portion of the highest wager which was not called by any other player.
+// Dead blinds are never returned, so that only live chips are compared.
+func (g *game) returnUncalledBet() {
+
+	var top *PlayerState
+	topContributed := int64(0)
+	second := int64(0)
+	for _, p := range g.gs.Players {
+
+		contributed := p.Pot + p.Wager - p.DeadBlind
+
+		if top == nil || contributed > topContributed {
+			if top != nil {
+				second = topContributed
+			}
+
+			top = p
+			topContributed = contributed
+		} else if contributed > second {
+			second = contributed
+		}
+	}
+
+	if top == nil {
+		return
+	}
+
+	uncalled := topContributed - second
+	if uncalled <= 0 {
+		return
+	}
+
+	// Uncalled chips are taken from the wager of this round first
+	fromWager := uncalled
+	if fromWager > top.Wager {
+		fromWager = top.Wager
+	}
+
+	top.Wager -= fromWager
+	top.Pot -= uncalled - fromWager
+	top.StackSize += uncalled
+	top.UncalledBet = uncalled
+}
+
 func (g *game) PrintPots() {
 
 	for _, p := range g.GetState().Status.Pots {
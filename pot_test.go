@@ -0,0 +1,138 @@
+package pokerlib
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Pot_SidePots(t *testing.T) {
+
+	g := startTestGame(t, newTestGameOptions(300, 10000, 10000, 100))
+	assert.Empty(t, g.Pots())
+
+	assert.Nil(t, g.Allin())
+	assert.Nil(t, g.Allin())
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Call())
+
+	// Player who went all-in for less passes
+	assert.Nil(t, g.Pass())
+	assert.Equal(t, "flop", g.GetState().Status.Round)
+
+	pots := g.Pots()
+	assert.Len(t, pots, 2)
+
+	// Main pot is contributed by everyone up to the smallest all-in
+	assert.Equal(t, int64(400), pots[0].Amount)
+	assert.Equal(t, []int{0, 1, 2, 3}, pots[0].EligibleSeats)
+
+	// Side pot excludes player who is all-in for less
+	assert.Equal(t, int64(600), pots[1].Amount)
+	assert.Equal(t, []int{0, 1, 2}, pots[1].EligibleSeats)
+}
+
+func Test_Pot_AllInPlayers(t *testing.T) {
+
+	g := startTestGame(t, newTestGameOptions(300, 10000, 10000, 100))
+	assert.Empty(t, g.AllInPlayers())
+
+	assert.Nil(t, g.Allin())
+	assert.Nil(t, g.Allin())
+
+	// Committed chips are in wager before round is closed
+	assert.Equal(t, []AllInInfo{{Idx: 3, Committed: 100}, {Idx: 0, Committed: 300}}, g.AllInPlayers())
+
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Pass())
+	assert.Equal(t, "flop", g.GetState().Status.Round)
+
+	// Committed chips are in pot after round is closed
+	assert.Equal(t, []AllInInfo{{Idx: 3, Committed: 100}, {Idx: 0, Committed: 300}}, g.AllInPlayers())
+}
+
+func Test_Pot_MultiwayAllin(t *testing.T) {
+
+	opts := newTestGameOptions(50, 150, 300, 1000, 1000)
+	opts.PresetBoard = []string{"S2", "D7", "C9", "HJ", "D3"}
+	opts.Players[0].PresetHoleCards = []string{"SA", "HA"}
+	opts.Players[1].PresetHoleCards = []string{"SK", "HK"}
+	opts.Players[2].PresetHoleCards = []string{"SQ", "HQ"}
+	opts.Players[3].PresetHoleCards = []string{"C4", "H5"}
+
+	g := startTestGame(t, opts)
+
+	// Preflop
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Allin())
+	assert.Nil(t, g.Allin())
+	assert.Nil(t, g.Allin())
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Fold())
+
+	// Players who went all-in pass
+	assert.Nil(t, g.Pass())
+	assert.Nil(t, g.Pass())
+
+	gs := g.GetState()
+	assert.Equal(t, "GameClosed", gs.Status.CurrentEvent)
+
+	// Folded player contributed to main pot but is not eligible to win it
+	pots := g.Pots()
+	assert.Len(t, pots, 3)
+	assert.Equal(t, int64(210), pots[0].Amount)
+	assert.Equal(t, []int{0, 1, 2, 3}, pots[0].EligibleSeats)
+	assert.Equal(t, int64(300), pots[1].Amount)
+	assert.Equal(t, []int{1, 2, 3}, pots[1].EligibleSeats)
+	assert.Equal(t, int64(300), pots[2].Amount)
+	assert.Equal(t, []int{2, 3}, pots[2].EligibleSeats)
+
+	// Each pot goes to the best hand among its eligible players
+	assert.Len(t, gs.Result.Pots, 3)
+	for i, winner := range []int{0, 1, 2} {
+		assert.Len(t, gs.Result.Pots[i].Winners, 1)
+		assert.Equal(t, winner, gs.Result.Pots[i].Winners[0].Idx)
+		assert.Equal(t, pots[i].Amount, gs.Result.Pots[i].Winners[0].Withdraw)
+	}
+
+	finals := []int64{210, 300, 300, 700, 990}
+	for _, rs := range gs.Result.Players {
+		assert.Equal(t, finals[rs.Idx], rs.Final)
+	}
+}
+
+func Test_Pot_Rake(t *testing.T) {
+
+	opts := newTestGameOptions(300, 10000, 10000, 100)
+	opts.Rake = RakeSetting{BasisPoints: 500, Cap: 25}
+
+	g := startTestGame(t, opts)
+
+	assert.Nil(t, g.Allin())
+	assert.Nil(t, g.Allin())
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Pass())
+
+	// Main pot is raked first until cap was reached
+	pots := g.Pots()
+	assert.Len(t, pots, 2)
+	assert.Equal(t, int64(20), pots[0].Rake)
+	assert.Equal(t, int64(5), pots[1].Rake)
+	assert.Equal(t, int64(1000-25), g.PotAfterRake())
+
+	// Without cap
+	opts = newTestGameOptions(10000, 10000, 10000)
+	opts.Rake = RakeSetting{BasisPoints: 1000}
+
+	g = startTestGame(t, opts)
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Check())
+	assert.Equal(t, int64(30-3), g.PotAfterRake())
+
+	opts.Rake = RakeSetting{BasisPoints: 10001}
+	assert.Equal(t, ErrInvalidGameConfig, opts.Validate())
+}
@@ -15,15 +15,23 @@ func (g *game) CalculatePlayerPower(p *PlayerState) *combination.PowerState {
 	return powers[0]
 }
 
+// OnCombinationUpdated registers a callback to notify clients of changes of the best combination
+// instead of polling. Callback is invoked synchronously, so it must not call back into the game.
+func (g *game) OnCombinationUpdated(fn func(idx int, info *CombinationInfo)) {
+	g.onCombinationUpdated = fn
+}
+
 func (g *game) UpdateCombinationOfAllPlayers() error {
 
 	for _, p := range g.gs.Players {
-		ps := g.CalculatePlayerPower(p)
-
 		if p.Combination == nil {
 			continue
 		}
 
+		ps := g.CalculatePlayerPower(p)
+
+		prev := *p.Combination
+
 		p.Combination.Type = combination.CombinationSymbol[ps.Combination]
 
 		// Override old cards
@@ -33,6 +41,10 @@ func (g *game) UpdateCombinationOfAllPlayers() error {
 		}
 
 		p.Combination.Power = int(ps.Score)
+
+		if g.onCombinationUpdated != nil && !prev.equal(p.Combination) {
+			g.onCombinationUpdated(p.Idx, p.Combination)
+		}
 	}
 
 	return nil
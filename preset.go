@@ -0,0 +1,128 @@
+package pokerlib
+
+import "errors"
+
+var (
+	ErrInvalidPresetCards = errors.New("game: invalid preset cards")
+)
+
+func (g *game) hasPresetCards() bool {
+	return len(g.presetBoard) > 0 || len(g.presetHoleCards) > 0
+}
+
+// boardPositions returns positions in deck of board cards if board cards start to be dealt at the
+// specific position. One card is burned before each street.
+func (g *game) boardPositions(pos int) []int {
+
+	positions := make([]int, 0)
+	for _, count := range g.boardLayout() {
+
+		// Burn
+		pos++
+
+		for i := 0; i < count; i++ {
+			positions = append(positions, pos)
+			pos++
+		}
+	}
+
+	return positions
+}
+
+func (g *game) validatePresetCards() error {
+
+	if !g.hasPresetCards() {
+		return nil
+	}
+
+	if len(g.presetBoard) > len(g.boardPositions(0)) {
+		return ErrInvalidPresetCards
+	}
+
+	inDeck := make(map[string]bool, len(g.gs.Meta.Deck))
+	for _, c := range g.gs.Meta.Deck {
+		inDeck[c] = true
+	}
+
+	used := make(map[string]bool)
+	check := func(cards []string) error {
+		for _, c := range cards {
+			if !inDeck[c] || used[c] {
+				return ErrInvalidPresetCards
+			}
+
+			used[c] = true
+		}
+
+		return nil
+	}
+
+	for idx, cards := range g.presetHoleCards {
+
+		ps := g.gs.GetPlayer(idx)
+		if ps == nil || ps.isDealtOut() || len(cards) > g.gs.Meta.HoleCardsCount {
+			return ErrInvalidPresetCards
+		}
+
+		err := check(cards)
+		if err != nil {
+			return err
+		}
+	}
+
+	return check(g.presetBoard)
+}
+
+// arrangePresetCards places preset cards in deck where they will be dealt, and other cards fill the
+// remaining positions in the order of the shuffled deck.
+func (g *game) arrangePresetCards() {
+
+	if !g.hasPresetCards() {
+		return
+	}
+
+	deck := g.gs.Meta.Deck
+	slots := make([]string, len(deck))
+
+	// Hole cards are placed in the order which they are dealt to players
+	pos := 0
+	dealt := make(map[int]int)
+	for _, idx := range g.holeCardsDealingOrder() {
+		preset := g.presetHoleCards[idx]
+		if dealt[idx] < len(preset) {
+			slots[pos] = preset[dealt[idx]]
+		}
+
+		dealt[idx]++
+		pos++
+	}
+
+	boardPositions := g.boardPositions(pos)
+	for i, c := range g.presetBoard {
+		slots[boardPositions[i]] = c
+	}
+
+	preset := make(map[string]bool)
+	for _, c := range slots {
+		if len(c) > 0 {
+			preset[c] = true
+		}
+	}
+
+	// Fill the rest with cards which were not preset
+	remaining := make([]string, 0, len(deck))
+	for _, c := range deck {
+		if !preset[c] {
+			remaining = append(remaining, c)
+		}
+	}
+
+	for i := range slots {
+		if len(slots[i]) == 0 {
+			slots[i] = remaining[0]
+			remaining = remaining[1:]
+		}
+	}
+
+	g.gs.Meta.Deck = slots
+}
@@ -0,0 +1,59 @@
+package pokerlib
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Preset_BadBeat(t *testing.T) {
+
+	opts := newTestGameOptions(10000, 10000, 10000)
+	opts.Players[0].PresetHoleCards = []string{"SA", "HA"}
+	opts.Players[1].PresetHoleCards = []string{"CK", "DK"}
+	opts.PresetBoard = []string{"SQ", "D7", "C2", "H9", "HK"}
+
+	g := startTestGame(t, opts)
+	gs := g.GetState()
+
+	assert.Equal(t, []string{"SA", "HA"}, gs.Players[0].HoleCards)
+	assert.Equal(t, []string{"CK", "DK"}, gs.Players[1].HoleCards)
+	assert.ElementsMatch(t, NewStandardDeckCards(), gs.Meta.Deck)
+
+	// Both go all-in and the other player folds
+	assert.Nil(t, g.Allin())
+	assert.Nil(t, g.Allin())
+	assert.Nil(t, g.Fold())
+
+	for g.GetEvent() != "GameClosed" {
+		assert.Equal(t, "ReadyRequested", g.GetEvent())
+		assert.Nil(t, g.ReadyForAll())
+	}
+
+	assert.Equal(t, opts.PresetBoard, gs.Status.Board)
+	assert.Len(t, gs.Status.Burned, 3)
+
+	// King on the river
+	assert.Equal(t, int64(0), gs.Result.Players[0].Final)
+	assert.Equal(t, int64(20010), gs.Result.Players[1].Final)
+}
+
+func Test_Preset_InvalidCards(t *testing.T) {
+
+	// Duplicate card
+	opts := newTestGameOptions(10000, 10000, 10000)
+	opts.Players[0].PresetHoleCards = []string{"SA", "HA"}
+	opts.PresetBoard = []string{"SA"}
+	assert.ErrorIs(t, NewGame(opts).Start(), ErrInvalidPresetCards)
+
+	// Card is not in deck
+	opts = newTestGameOptions(10000, 10000, 10000)
+	opts.Deck = NewShortDeckCards()
+	opts.Players[0].PresetHoleCards = []string{"S2"}
+	assert.ErrorIs(t, NewGame(opts).Start(), ErrInvalidPresetCards)
+
+	// Too many hole cards
+	opts = newTestGameOptions(10000, 10000, 10000)
+	opts.Players[0].PresetHoleCards = []string{"SA", "HA", "DA"}
+	assert.ErrorIs(t, NewGame(opts).Start(), ErrInvalidPresetCards)
+}
@@ -0,0 +1,120 @@
+package pokerlib
+
+import (
+	"encoding/json"
+)
+
+// PublicGameState is a stable representation of game state for clients, which contains only the
+// information that the requesting seat is allowed to see.
+type PublicGameState struct {
+	GameID        string               `json:"game_id"`
+	Ante          int64                `json:"ante"`
+	Blind         BlindSetting         `json:"blind"`
+	Limit         string               `json:"limit"`
+	Round         string               `json:"round"`
+	Event         string               `json:"event"`
+	Board         []string             `json:"board"`
+	Pots          []*PublicPot         `json:"pots"`
+	MiniBet       int64                `json:"mini_bet"`
+	CurrentWager  int64                `json:"current_wager"`
+	CurrentPlayer int                  `json:"current_player"`
+	LastAction    *Action              `json:"last_action,omitempty"`
+	Players       []*PublicPlayerState `json:"players"`
+}
+
+type PublicPot struct {
+	Total        int64 `json:"total"`
+	Contributors []int `json:"contributors"`
+}
+
+type PublicPlayerState struct {
+	Idx            int      `json:"idx"`
+	Positions      []string `json:"positions"`
+	Fold           bool     `json:"fold"`
+	DidAction      string   `json:"did_action"`
+	Bankroll       int64    `json:"bankroll"`
+	StackSize      int64    `json:"stack_size"`
+	Pot            int64    `json:"pot"`
+	Wager          int64    `json:"wager"`
+	HoleCards      []string `json:"hole_cards"`
+	AllowedActions []string `json:"allowed_actions"`
+}
+
+// NewPublicGameState creates public state for the specific seat. Hole cards of other players are
+// revealed only if game was closed and they did not fold, otherwise only cards they chose to show.
+func NewPublicGameState(gs *GameState, forSeat int) *PublicGameState {
+
+	pgs := &PublicGameState{
+		GameID:        gs.GameID,
+		Ante:          gs.Meta.Ante,
+		Blind:         gs.Meta.Blind,
+		Limit:         gs.Meta.Limit,
+		Round:         gs.Status.Round,
+		Event:         gs.Status.CurrentEvent,
+		Board:         make([]string, 0),
+		Pots:          make([]*PublicPot, 0),
+		MiniBet:       gs.Status.MiniBet,
+		CurrentWager:  gs.Status.CurrentWager,
+		CurrentPlayer: gs.Status.CurrentPlayer,
+		Players:       make([]*PublicPlayerState, 0, len(gs.Players)),
+	}
+
+	pgs.Board = append(pgs.Board, gs.Status.Board...)
+
+	if gs.Status.LastAction != nil {
+		a := *gs.Status.LastAction
+		pgs.LastAction = &a
+	}
+
+	for _, p := range gs.Status.Pots {
+
+		pp := &PublicPot{
+			Total:        p.Total,
+			Contributors: make([]int, 0),
+		}
+
+		for _, ps := range gs.Players {
+			if p.ContributorExists(ps.Idx) {
+				pp.Contributors = append(pp.Contributors, ps.Idx)
+			}
+		}
+
+		pgs.Pots = append(pgs.Pots, pp)
+	}
+
+	for _, p := range gs.Players {
+
+		pps := &PublicPlayerState{
+			Idx:            p.Idx,
+			Positions:      make([]string, 0),
+			Fold:           p.Fold,
+			DidAction:      p.DidAction,
+			Bankroll:       p.Bankroll,
+			StackSize:      p.StackSize,
+			Pot:            p.Pot,
+			Wager:          p.Wager,
+			HoleCards:      make([]string, 0),
+			AllowedActions: make([]string, 0),
+		}
+
+		pps.Positions = append(pps.Positions, p.Positions...)
+
+		if p.Idx == forSeat {
+			pps.HoleCards = append(pps.HoleCards, p.HoleCards...)
+			pps.AllowedActions = append(pps.AllowedActions, p.AllowedActions...)
+		} else if gs.Status.CurrentEvent == "GameClosed" && !p.Fold {
+			pps.HoleCards = append(pps.HoleCards, p.HoleCards...)
+		} else {
+			pps.HoleCards = append(pps.HoleCards, p.shownHoleCards()...)
+		}
+
+		pgs.Players = append(pgs.Players, pps)
+	}
+
+	return pgs
+}
+
+// GetPublicStateJSON returns public state for the specific seat. Use -1 for observers.
+func (g *game) GetPublicStateJSON(forSeat int) ([]byte, error) {
+	return json.Marshal(NewPublicGameState(g.gs, forSeat))
+}
@@ -0,0 +1,93 @@
+package pokerlib
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_PublicState(t *testing.T) {
+
+	g := startTestGame(t, newTestGameOptions(10000, 10000, 10000))
+
+	data, err := g.GetPublicStateJSON(1)
+	assert.Nil(t, err)
+
+	// Remaining deck and burned cards should never be exposed
+	var raw map[string]interface{}
+	assert.Nil(t, json.Unmarshal(data, &raw))
+	assert.NotContains(t, raw, "meta")
+	assert.NotContains(t, raw, "deck")
+	assert.NotContains(t, raw, "burned")
+	assert.NotContains(t, string(data), g.GetState().Meta.Deck[10])
+
+	var pgs PublicGameState
+	assert.Nil(t, json.Unmarshal(data, &pgs))
+	assert.Equal(t, "preflop", pgs.Round)
+	assert.Equal(t, "RoundStarted", pgs.Event)
+	assert.Equal(t, int64(10), pgs.CurrentWager)
+	assert.Equal(t, 3, len(pgs.Players))
+
+	// Only requesting seat has hole cards
+	for _, p := range pgs.Players {
+		if p.Idx == 1 {
+			assert.Equal(t, g.Player(1).State().HoleCards, p.HoleCards)
+			continue
+		}
+
+		assert.Empty(t, p.HoleCards)
+		assert.Empty(t, p.AllowedActions)
+	}
+
+	// Observer
+	data, err = g.GetPublicStateJSON(-1)
+	assert.Nil(t, err)
+	assert.Nil(t, json.Unmarshal(data, &pgs))
+	for _, p := range pgs.Players {
+		assert.Empty(t, p.HoleCards)
+	}
+}
+
+func Test_PublicState_ShowCard(t *testing.T) {
+
+	g := startTestGame(t, newTestGameOptions(10000, 10000, 10000))
+
+	// Hand is not complete yet
+	assert.ErrorIs(t, g.ShowCard(0, 0), ErrHandNotComplete)
+
+	// Dealer and small blind fold, so that big blind takes the pot
+	assert.Nil(t, g.Fold())
+	assert.Nil(t, g.Fold())
+	assert.True(t, g.IsHandComplete())
+
+	assert.ErrorIs(t, g.ShowCard(0, 2), ErrInvalidCardIndex)
+	assert.ErrorIs(t, g.ShowCard(5, 0), ErrNotFoundPlayer)
+	assert.Nil(t, g.ShowCard(0, 1))
+
+	holeCards := g.Player(0).State().HoleCards
+
+	// Only the shown card is visible to others
+	for _, forSeat := range []int{2, -1} {
+		data, err := g.GetPublicStateJSON(forSeat)
+		assert.Nil(t, err)
+
+		var pgs PublicGameState
+		assert.Nil(t, json.Unmarshal(data, &pgs))
+		assert.Equal(t, []string{holeCards[1]}, pgs.Players[0].HoleCards)
+		assert.Empty(t, pgs.Players[1].HoleCards)
+	}
+
+	gs := g.GetState().Clone()
+	gs.AsPlayer(2)
+	assert.Equal(t, []string{holeCards[1]}, gs.Players[0].HoleCards)
+	assert.Empty(t, gs.Players[1].HoleCards)
+
+	// Owner still sees both cards
+	data, err := g.GetPublicStateJSON(0)
+	assert.Nil(t, err)
+
+	var pgs PublicGameState
+	assert.Nil(t, json.Unmarshal(data, &pgs))
+	assert.Equal(t, holeCards, pgs.Players[0].HoleCards)
+}
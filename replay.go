@@ -0,0 +1,136 @@
+package pokerlib
+
+import (
+	"errors"
+)
+
+var (
+	ErrUnknownActionType = errors.New("replay: unknown action type")
+	ErrInvalidIndex      = errors.New("replay: invalid index")
+	ErrActionMismatch    = errors.New("replay: action does not match")
+)
+
+// Replayer rebuilds game states by applying recorded actions in order. The deck of options is
+// used as-is without shuffling, so it should be the deck of the recorded game.
+type Replayer struct {
+	opts    *GameOptions
+	actions []*Action
+}
+
+func NewReplayer(opts *GameOptions, actions []*Action) *Replayer {
+	return &Replayer{
+		opts:    opts,
+		actions: actions,
+	}
+}
+
+// ReplayGame applies all recorded actions and returns the final game.
+func ReplayGame(opts *GameOptions, actions []*Action) (*game, error) {
+	return NewReplayer(opts, actions).ReplayToIndex(len(actions))
+}
+
+// ReplayToIndex applies the first n recorded actions and returns the game.
+func (r *Replayer) ReplayToIndex(n int) (*game, error) {
+
+	if n < 0 || n > len(r.actions) {
+		return nil, ErrInvalidIndex
+	}
+
+	g := NewGame(r.opts)
+	g.noShuffle = true
+
+	err := g.Start()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, a := range r.actions[:n] {
+
+		// Action was generated by the engine already, e.g., for players who are sitting out
+		if i < len(g.gs.Status.ActionHistory) {
+			if *g.gs.Status.ActionHistory[i] != *a {
+				return nil, ErrActionMismatch
+			}
+
+			continue
+		}
+
+		err := g.applyAction(a)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return g, nil
+}
+
+func (g *game) applyAction(a *Action) error {
+
+	switch a.Type {
+	case "ready":
+		return g.ReadyForAll()
+	case "next":
+		// Game goes to the next round automatically once round was closed
+		return nil
+	case "ante":
+		// All players pay for ante at the same time
+		if g.gs.Status.CurrentEvent != "AnteRequested" {
+			return nil
+		}
+
+		return g.PayAnte()
+	case "dealer_blind", "small_blind", "big_blind", "kill_blind", "posted_blind", "dead_blind", "bring_in":
+		// All blinds are paid at the same time
+		if g.gs.Status.CurrentEvent != "BlindsRequested" {
+			return nil
+		}
+
+		return g.PayBlinds()
+	case "extend_time":
+		return g.ExtendPlayerTime(a.Source, a.Value)
+	case "dead_hand":
+		return g.MarkDeadHand(a.Source)
+	case "cash_out":
+		// Recorded payout is applied rather than estimating equity again
+		ps, err := g.cashOutPlayer(a.Source, a.Fraction)
+		if err != nil {
+			return err
+		}
+
+		return g.cashOut(ps, a.Fraction, a.Value)
+	}
+
+	p := g.Player(a.Source)
+	if p == nil {
+		return ErrInvalidAction
+	}
+
+	// Player was folded out of turn by ForceFold
+	if a.Type == "fold" && g.gs.Status.CurrentPlayer != a.Source {
+		return g.ForceFold(a.Source)
+	}
+
+	switch a.Type {
+	case "pass":
+		return p.Pass()
+	case "pay":
+		return p.Pay(a.Value)
+	case "fold":
+		return p.Fold()
+	case "check":
+		return p.Check()
+	case "call":
+		return p.Call()
+	case "allin":
+		return p.Allin()
+	case "bet":
+		return p.Bet(a.Value)
+	case "complete":
+		return p.Complete()
+	case "raise":
+		// Value of raise is the chips paid rather than chip level
+		return p.Raise(p.State().Wager + a.Value)
+	}
+
+	return ErrUnknownActionType
+}
@@ -0,0 +1,157 @@
+package pokerlib
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Replay(t *testing.T) {
+
+	opts := newTestGameOptions(10000, 10000, 10000, 10000)
+	opts.Ante = 10
+
+	// Live run
+	g := NewGame(opts)
+	assert.Nil(t, g.Start())
+	assert.Nil(t, g.ReadyForAll())
+	assert.Nil(t, g.PayAnte())
+	assert.Nil(t, g.PayBlinds())
+	assert.Nil(t, g.ReadyForAll())
+
+	// Preflop
+	assert.Nil(t, g.Raise(30))
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Fold())
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.ReadyForAll())
+
+	// Flop
+	assert.Nil(t, g.Pass())
+	assert.Nil(t, g.Check())
+	assert.Nil(t, g.Bet(100))
+	assert.Nil(t, g.Call())
+	betIdx := len(g.GetState().Status.ActionHistory)
+	assert.Nil(t, g.Pass())
+	assert.Nil(t, g.Raise(300))
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Fold())
+	assert.Nil(t, g.Pass())
+	assert.Nil(t, g.ReadyForAll())
+
+	// Turn
+	assert.Nil(t, g.Pass())
+	assert.Nil(t, g.Check())
+	assert.Nil(t, g.Check())
+	assert.Nil(t, g.Pass())
+	assert.Nil(t, g.ReadyForAll())
+
+	// River
+	assert.Nil(t, g.Pass())
+	assert.Nil(t, g.Allin())
+	assert.Nil(t, g.Allin())
+	assert.Equal(t, "GameClosed", g.GetEvent())
+	assert.ErrorIs(t, g.Pass(), ErrHandComplete)
+
+	// Replay with deck of the live game
+	replayOpts := newTestGameOptions(10000, 10000, 10000, 10000)
+	replayOpts.Ante = 10
+	replayOpts.Deck = g.GetState().Meta.Deck
+
+	history := g.GetState().Status.ActionHistory
+
+	rg, err := ReplayGame(replayOpts, history)
+	assert.Nil(t, err)
+	assert.Equal(t, "GameClosed", rg.GetEvent())
+	assert.Equal(t, g.GetState().Checksum(), rg.GetState().Checksum())
+	assert.Equal(t, g.GetState().Result.Players, rg.GetState().Result.Players)
+
+	// Stepping
+	r := NewReplayer(replayOpts, history)
+	sg, err := r.ReplayToIndex(betIdx)
+	assert.Nil(t, err)
+	assert.Equal(t, "flop", sg.GetState().Status.Round)
+	assert.Equal(t, 3, len(sg.GetState().Status.Board))
+	assert.Equal(t, "call", sg.GetState().Status.LastAction.Type)
+	assert.Equal(t, int64(100), sg.GetState().Status.CurrentWager)
+	assert.Equal(t, 1, sg.GetCurrentPlayer().SeatIndex())
+
+	sg, err = r.ReplayToIndex(0)
+	assert.Nil(t, err)
+	assert.Equal(t, "ReadyRequested", sg.GetEvent())
+
+	_, err = r.ReplayToIndex(len(history) + 1)
+	assert.Equal(t, ErrInvalidIndex, err)
+}
+
+func Test_Replay_ForceFold(t *testing.T) {
+
+	opts := newTestGameOptions(10000, 10000, 10000, 10000)
+
+	// Dealer is folded out of turn
+	g := startTestGame(t, opts)
+	assert.Nil(t, g.ForceFold(0))
+	playToShowdown(t, g)
+
+	replayOpts := newTestGameOptions(10000, 10000, 10000, 10000)
+	replayOpts.Deck = g.GetState().Meta.Deck
+
+	rg, err := ReplayGame(replayOpts, g.GetState().Status.ActionHistory)
+	assert.Nil(t, err)
+	assert.True(t, rg.Player(0).State().Fold)
+	assert.Equal(t, g.GetState().Result.Players, rg.GetState().Result.Players)
+}
+
+func Test_Replay_SitOut(t *testing.T) {
+
+	opts := newTestGameOptions(10000, 10000, 10000, 10000, 10000)
+	opts.Players[2].SitOut = true
+	opts.Players[3].SitOut = true
+
+	// Players who are sitting out are folded and checked by the engine
+	g := startTestGame(t, opts)
+	playToShowdown(t, g)
+	assert.True(t, g.Player(3).State().Fold)
+	assert.False(t, g.Player(2).State().Fold)
+
+	replayOpts := newTestGameOptions(10000, 10000, 10000, 10000, 10000)
+	replayOpts.Players[2].SitOut = true
+	replayOpts.Players[3].SitOut = true
+	replayOpts.Deck = g.GetState().Meta.Deck
+
+	history := g.GetState().Status.ActionHistory
+	rg, err := ReplayGame(replayOpts, history)
+	assert.Nil(t, err)
+	assert.Equal(t, "GameClosed", rg.GetEvent())
+	assert.Equal(t, len(history), len(rg.GetState().Status.ActionHistory))
+	assert.Equal(t, g.GetState().Result.Players, rg.GetState().Result.Players)
+}
+
+func Test_Replay_CashOut(t *testing.T) {
+
+	opts := newTestGameOptions(1000, 1000, 1000, 1000)
+	opts.PresetBoard = []string{"SA", "D7", "C2", "H9", "D3"}
+	opts.Players[2].PresetHoleCards = []string{"SQ", "HQ"}
+	opts.Players[3].PresetHoleCards = []string{"CA", "HK"}
+
+	// Player is paid right after all-in
+	g := startTestGame(t, opts)
+	assert.Nil(t, g.Allin())
+	assert.Nil(t, g.Fold())
+	assert.Nil(t, g.Fold())
+	payout, err := g.CashOut(3, 0.5)
+	assert.Nil(t, err)
+	assert.Nil(t, g.Call())
+	assert.True(t, g.IsHandComplete())
+
+	replayOpts := newTestGameOptions(1000, 1000, 1000, 1000)
+	replayOpts.Deck = g.GetState().Meta.Deck
+
+	// Recorded payout is applied as-is
+	rg, err := ReplayGame(replayOpts, g.GetState().Status.ActionHistory)
+	assert.Nil(t, err)
+	assert.Equal(t, "GameClosed", rg.GetEvent())
+	assert.Equal(t, payout, rg.Player(3).State().CashOut)
+	assert.Equal(t, 0.5, rg.Player(3).State().CashOutFraction)
+	assert.Equal(t, g.GetState().Result.Players, rg.GetState().Result.Players)
+}
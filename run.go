@@ -0,0 +1,128 @@
+package pokerlib
+
+import (
+	"fmt"
+
+	"github.com/d-protocol/pokerlib/combination"
+	"github.com/d-protocol/pokerlib/pot"
+)
+
+// runCount returns the number of times that the rest of board is dealt once players are all-in.
+func (g *game) runCount() int {
+
+	if g.gs.Meta.RunCount <= 1 {
+		return 1
+	}
+
+	return g.gs.Meta.RunCount
+}
+
+// validateRunCount checks that deck has enough cards to run the whole board for each run, which is
+// the worst case that players went all-in before flop.
+func (g *game) validateRunCount() error {
+
+	if g.runCount() == 1 {
+		return nil
+	}
+
+	required := g.getDealtInPlayerCount() * g.gs.Meta.HoleCardsCount
+	for _, count := range g.boardLayout() {
+
+		// One card is burned before each street
+		required += (1 + count) * g.runCount()
+	}
+
+	if required > len(g.gs.Meta.Deck) {
+		return ErrInsufficientCards
+	}
+
+	return nil
+}
+
+// runBoards returns boards of all runs, the first run is the board which was dealt as usual. The
+// rest of board after runout is dealt again for each additional run.
+func (g *game) runBoards() ([][]string, error) {
+
+	if g.runCount() == 1 || !g.gs.Status.Runout || g.GetAlivePlayerCount() <= 1 {
+		return [][]string{g.gs.Status.Board}, nil
+	}
+
+	if len(g.gs.Status.Runs) > 0 {
+		return g.gs.Status.Runs, nil
+	}
+
+	layout := g.boardLayout()
+	shared := 0
+	for _, count := range layout[:g.gs.Status.RunoutStreet] {
+		shared += count
+	}
+
+	runs := [][]string{append([]string{}, g.gs.Status.Board...)}
+	for run := 1; run < g.runCount(); run++ {
+
+		board := append([]string{}, g.gs.Status.Board[:shared]...)
+		for _, count := range layout[g.gs.Status.RunoutStreet:] {
+
+			if g.gs.Status.CurrentDeckPosition+1+count > len(g.gs.Meta.Deck) {
+				return nil, ErrInsufficientCards
+			}
+
+			g.Burn(1)
+			board = append(board, g.dealTo(fmt.Sprintf("run:%d", run+1), count)...)
+		}
+
+		runs = append(runs, board)
+	}
+
+	g.gs.Status.Runs = runs
+
+	return runs, nil
+}
+
+// runPower returns the power of the best combination of player with board of a run.
+func (g *game) runPower(p *PlayerState, board []string) int {
+
+	best := uint64(0)
+	for _, cards := range combination.GetAllPossibleCombinations(board, p.HoleCards, g.gs.Meta.RequiredHoleCardsCount) {
+		ps := g.CalculateCombinationPower(cards)
+		if ps.Score > best {
+			best = ps.Score
+		}
+	}
+
+	return int(best)
+}
+
+// splitPotForRun returns the share of pot for a run, every level of pot is split evenly by runs and
+// odd chips go to the first run.
+func splitPotForRun(p *pot.Pot, runs int, run int) (int64, []*pot.Level) {
+
+	if runs == 1 {
+		return p.Total, p.Levels
+	}
+
+	share := func(chips int64) int64 {
+		if run == 0 {
+			return chips/int64(runs) + chips%int64(runs)
+		}
+
+		return chips / int64(runs)
+	}
+
+	total := int64(0)
+	levels := make([]*pot.Level, 0, len(p.Levels))
+	for _, l := range p.Levels {
+
+		sl := &pot.Level{
+			Level:        l.Level,
+			Wager:        share(l.Wager),
+			Total:        share(l.Total),
+			Contributors: l.Contributors,
+		}
+
+		total += sl.Total
+		levels = append(levels, sl)
+	}
+
+	return total, levels
+}
@@ -0,0 +1,102 @@
+package pokerlib
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Run_ThreeTimes(t *testing.T) {
+
+	opts := newTestGameOptions(1000, 1000, 1000)
+	opts.RunCount = 3
+
+	var runDeals []string
+	g := NewGame(opts)
+	g.OnDeal(func(target string, cards []string) {
+		if target == "run:2" || target == "run:3" {
+			runDeals = append(runDeals, target)
+		}
+	})
+
+	assert.Nil(t, g.Start())
+	assert.Nil(t, g.ReadyForAll())
+	assert.Nil(t, g.PayBlinds())
+	assert.Nil(t, g.ReadyForAll())
+
+	// Preflop
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Check())
+	assert.Nil(t, g.ReadyForAll())
+
+	// Flop
+	assert.Nil(t, g.Allin())
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Fold())
+
+	playToShowdown(t, g)
+
+	gs := g.GetState()
+	assert.True(t, gs.Status.Runout)
+	assert.Equal(t, 1, gs.Status.RunoutStreet)
+
+	// Each run shares the flop and deals turn and river again
+	assert.Len(t, gs.Status.Runs, 3)
+	assert.Equal(t, gs.Status.Board, gs.Status.Runs[0])
+	for _, board := range gs.Status.Runs {
+		assert.Len(t, board, 5)
+		assert.Equal(t, gs.Status.Board[:3], board[:3])
+	}
+	assert.Equal(t, []string{"run:2", "run:2", "run:3", "run:3"}, runDeals)
+	assert.Len(t, gs.Status.Burned, 3+4)
+	assert.Nil(t, VerifyDeals(gs))
+
+	// Pot of 2010 is split into thirds
+	assert.Len(t, gs.Result.Pots, 3)
+	for run, p := range gs.Result.Pots {
+		assert.Equal(t, int64(670), p.Total)
+		assert.Equal(t, run, p.Run)
+
+		withdraw := int64(0)
+		for _, w := range p.Winners {
+			withdraw += w.Withdraw
+		}
+		assert.Equal(t, int64(670), withdraw)
+	}
+
+	final := int64(0)
+	for _, r := range gs.Result.Players {
+		final += r.Final
+	}
+	assert.Equal(t, int64(3000), final)
+}
+
+func Test_Run_InsufficientCards(t *testing.T) {
+
+	opts := newTestGameOptions(1000, 1000, 1000)
+	opts.RunCount = 6
+	assert.ErrorIs(t, NewGame(opts).Start(), ErrInsufficientCards)
+
+	opts.RunCount = 5
+	assert.Nil(t, NewGame(opts).Start())
+
+	opts.RunCount = -1
+	assert.Equal(t, ErrInvalidGameConfig, opts.Validate())
+}
+
+func Test_Run_NoRunout(t *testing.T) {
+
+	opts := newTestGameOptions(1000, 1000, 1000)
+	opts.RunCount = 3
+
+	// Board is dealt only once without all-in
+	g := startTestGame(t, opts)
+	playToShowdown(t, g)
+
+	gs := g.GetState()
+	assert.False(t, gs.Status.Runout)
+	assert.Empty(t, gs.Status.Runs)
+	assert.Len(t, gs.Result.Pots, 1)
+	assert.Equal(t, int64(30), gs.Result.Pots[0].Total)
+}
@@ -0,0 +1,157 @@
+package pokerlib
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrSelfTestDuplicateCard = errors.New("selftest: duplicate card")
+	ErrSelfTestUnknownCard   = errors.New("selftest: card is not in deck")
+	ErrSelfTestIncomplete    = errors.New("selftest: cards were not fully dealt")
+	ErrSelfTestDeckMismatch  = errors.New("selftest: shuffled deck does not match the full deck")
+)
+
+// selfTestPlayerCount is the number of players of a full table
+const selfTestPlayerCount = 9
+
+// SelfTest shuffles standard and short decks, deals a full table to showdown and verifies that the
+// shuffled deck has exactly the cards of the full deck, and every card of hole cards, board and burned
+// cards appears in the deck only once. It is a cheap smoke test of the shuffle and deal pipeline for
+// startup checks.
+func SelfTest() error {
+
+	err := selfTestDeck(NewStandardGameOptions(), NewStandardDeckCards())
+	if err != nil {
+		return fmt.Errorf("standard deck: %w", err)
+	}
+
+	err = selfTestDeck(NewShortDeckGameOptions(), NewShortDeckCards())
+	if err != nil {
+		return fmt.Errorf("short deck: %w", err)
+	}
+
+	return nil
+}
+
+// selfTestDeck deals a full table with the full deck, which is the reference of shuffled deck.
+func selfTestDeck(opts *GameOptions, fullDeck []string) error {
+
+	opts.Deck = append([]string{}, fullDeck...)
+	opts.Players = make([]*PlayerSetting, 0, selfTestPlayerCount)
+	opts.Players = append(opts.Players, NewDealer(10000), NewSmallBlind(10000), NewBigBlind(10000))
+	for len(opts.Players) < selfTestPlayerCount {
+		opts.Players = append(opts.Players, NewPlayer(10000))
+	}
+
+	g := NewGame(opts)
+	err := g.Start()
+	if err != nil {
+		return err
+	}
+
+	// Everyone checks or calls down to showdown, so that all cards are dealt
+	for !g.IsHandComplete() {
+
+		switch {
+		case g.GetEvent() == "ReadyRequested":
+			err = g.ReadyForAll()
+		case g.GetEvent() == "BlindsRequested":
+			err = g.PayBlinds()
+		case g.GetCurrentPlayer() == nil:
+			err = ErrNotCurrentPlayer
+		case g.GetCurrentPlayer().CheckAction("call"):
+			err = g.Call()
+		case g.GetCurrentPlayer().CheckAction("check"):
+			err = g.Check()
+		default:
+			err = g.Pass()
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	gs := g.GetState()
+
+	// Shuffling should neither drop nor bring in any card
+	err = verifyShuffledDeck(fullDeck, gs.Meta.Deck)
+	if err != nil {
+		return err
+	}
+
+	dealt := make([]string, 0, len(gs.Meta.Deck))
+	for _, ps := range gs.Players {
+		if len(ps.HoleCards) != gs.Meta.HoleCardsCount {
+			return fmt.Errorf("%w: player %d has %d hole cards", ErrSelfTestIncomplete, ps.Idx, len(ps.HoleCards))
+		}
+
+		dealt = append(dealt, ps.HoleCards...)
+	}
+
+	if len(gs.Status.Board) != 5 {
+		return fmt.Errorf("%w: board has %d cards", ErrSelfTestIncomplete, len(gs.Status.Board))
+	}
+
+	dealt = append(dealt, gs.Status.Board...)
+	dealt = append(dealt, gs.Status.Burned...)
+
+	return verifyDealtCards(gs.Meta.Deck, dealt)
+}
+
+// verifyShuffledDeck checks that the shuffled deck has exactly the same cards as the full deck.
+func verifyShuffledDeck(fullDeck []string, shuffled []string) error {
+
+	if len(shuffled) != len(fullDeck) {
+		return fmt.Errorf("%w: %d cards rather than %d", ErrSelfTestDeckMismatch, len(shuffled), len(fullDeck))
+	}
+
+	inDeck := make(map[string]bool, len(fullDeck))
+	for _, c := range fullDeck {
+		inDeck[c] = true
+	}
+
+	seen := make(map[string]bool, len(shuffled))
+	for _, c := range shuffled {
+		if !inDeck[c] {
+			return fmt.Errorf("%w: %s", ErrSelfTestUnknownCard, c)
+		}
+
+		if seen[c] {
+			return fmt.Errorf("%w: %s in deck", ErrSelfTestDuplicateCard, c)
+		}
+
+		seen[c] = true
+	}
+
+	return nil
+}
+
+// verifyDealtCards checks that dealt cards are in the deck and no card is dealt twice.
+func verifyDealtCards(deck []string, dealt []string) error {
+
+	inDeck := make(map[string]bool, len(deck))
+	for _, c := range deck {
+		if inDeck[c] {
+			return fmt.Errorf("%w: %s in deck", ErrSelfTestDuplicateCard, c)
+		}
+
+		inDeck[c] = true
+	}
+
+	seen := make(map[string]bool, len(dealt))
+	for _, c := range dealt {
+		if !inDeck[c] {
+			return fmt.Errorf("%w: %s", ErrSelfTestUnknownCard, c)
+		}
+
+		if seen[c] {
+			return fmt.Errorf("%w: %s", ErrSelfTestDuplicateCard, c)
+		}
+
+		seen[c] = true
+	}
+
+	return nil
+}
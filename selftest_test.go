@@ -0,0 +1,61 @@
+package pokerlib
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelfTest(t *testing.T) {
+
+	assert.Nil(t, SelfTest())
+
+	// Standard deck
+	assert.Nil(t, selfTestDeck(NewStandardGameOptions(), NewStandardDeckCards()))
+
+	// Short deck
+	assert.Nil(t, selfTestDeck(NewShortDeckGameOptions(), NewShortDeckCards()))
+}
+
+func TestSelfTestShuffledDeck(t *testing.T) {
+
+	full := NewStandardDeckCards()
+	shuffled := ShuffleCards(NewStandardDeckCards())
+	assert.Nil(t, verifyShuffledDeck(full, shuffled))
+
+	// A card was dropped
+	err := verifyShuffledDeck(full, shuffled[1:])
+	assert.True(t, errors.Is(err, ErrSelfTestDeckMismatch))
+
+	// A foreign card was swapped in
+	swapped := append([]string{}, shuffled...)
+	swapped[0] = "XX"
+	err = verifyShuffledDeck(full, swapped)
+	assert.True(t, errors.Is(err, ErrSelfTestUnknownCard))
+	assert.Contains(t, err.Error(), "XX")
+
+	// A card was duplicated in place of another
+	swapped[0] = shuffled[1]
+	err = verifyShuffledDeck(full, swapped)
+	assert.True(t, errors.Is(err, ErrSelfTestDuplicateCard))
+}
+
+func TestSelfTestInvariants(t *testing.T) {
+
+	deck := NewShortDeckCards()
+	assert.Nil(t, verifyDealtCards(deck, []string{"SA", "HA", "D9"}))
+
+	err := verifyDealtCards(deck, []string{"SA", "HA", "SA"})
+	assert.True(t, errors.Is(err, ErrSelfTestDuplicateCard))
+	assert.Contains(t, err.Error(), "SA")
+
+	// Deuce is not a card of short deck
+	err = verifyDealtCards(deck, []string{"SA", "H2"})
+	assert.True(t, errors.Is(err, ErrSelfTestUnknownCard))
+	assert.Contains(t, err.Error(), "H2")
+
+	// Deck itself has duplicates
+	err = verifyDealtCards(append(deck, "SA"), []string{})
+	assert.True(t, errors.Is(err, ErrSelfTestDuplicateCard))
+}
@@ -48,13 +48,76 @@ func (g *game) CalculatePlayersRanking() []*RankInfo {
 		return ranks
 	}
 */
+// IsChopped returns true if the pot was split by multiple winners who tied at showdown.
+func (g *game) IsChopped(potIdx int) bool {
+
+	if g.gs.Result == nil || potIdx < 0 || potIdx >= len(g.gs.Result.Pots) {
+		return false
+	}
+
+	return g.gs.Result.Pots[potIdx].Chopped
+}
+
+// SeatsFromButton returns seats in clockwise order starting from the one left of the button, which
+// is the order to break ties such as odd chips of a split pot.
+func (g *game) SeatsFromButton() []int {
+
+	players := g.GetPlayers()
+	seats := make([]int, 0, len(players))
+	for i := 1; i <= len(players); i++ {
+		seats = append(seats, players[i%len(players)].SeatIndex())
+	}
+
+	return seats
+}
+
+// KillPotWinner returns the player who won a pot as large as threshold of kill pot without chopping,
+// who should be marked as killed for the next hand. It returns -1 if no pot was killed.
+func (g *game) KillPotWinner() int {
+
+	if g.gs.Meta.KillPot.Threshold <= 0 || g.gs.Result == nil {
+		return -1
+	}
+
+	winner := -1
+	largest := int64(0)
+	for _, p := range g.gs.Result.Pots {
+
+		if p.Chopped || len(p.Winners) != 1 || p.Total < g.gs.Meta.KillPot.Threshold {
+			continue
+		}
+
+		if p.Total > largest {
+			winner = p.Winners[0].Idx
+			largest = p.Total
+		}
+	}
+
+	return winner
+}
+
 func (g *game) CalculateGameResults() error {
 
 	r := settlement.NewResult()
 
-	// Initializing pot results
-	for _, pot := range g.gs.Status.Pots {
-		r.AddPot(pot.Total, pot.Levels)
+	boards, err := g.runBoards()
+	if err != nil {
+		return err
+	}
+
+	// Initializing pot results, each run takes a share of every pot after rake
+	rakes := g.potRakes()
+	for run := range boards {
+		for i, pot := range g.gs.Status.Pots {
+			total, levels := splitPotForRun(rakePot(pot, rakes[i]), len(boards), run)
+			r.AddPot(total, levels)
+			r.Pots[len(r.Pots)-1].Run = run
+
+			// Rake is recorded once
+			if run == 0 {
+				r.Pots[len(r.Pots)-1].Rake = rakes[i]
+			}
+		}
 	}
 
 	// Initializing player scores
@@ -62,17 +125,57 @@ func (g *game) CalculateGameResults() error {
 
 		r.AddPlayer(p.Idx, p.Bankroll)
 
-		// No score if player fold already
-		if p.Fold {
+		if p.UncalledBet > 0 {
+			r.AddUncalledBet(p.Idx, p.UncalledBet)
+		}
+
+		// Hand with wrong number of hole cards is dead
+		if !p.Fold && len(p.HoleCards) != g.gs.Meta.HoleCardsCount {
+			p.DeadHand = true
+		}
+
+		// No score if player fold already or hand is dead
+		if p.Fold || p.DeadHand {
 			r.UpdateScore(p.Idx, 0)
 			continue
 		}
 
-		r.UpdateScore(p.Idx, p.Combination.Power)
+		if len(boards) == 1 {
+			r.UpdateScore(p.Idx, p.Combination.Power)
+			continue
+		}
+
+		for run, board := range boards {
+			score := g.runPower(p, board)
+			for i := range g.gs.Status.Pots {
+				r.UpdatePotScore(run*len(g.gs.Status.Pots)+i, p.Idx, score)
+			}
+		}
 	}
 
+	// Odd chips go to the first winner left of the dealer
+	r.SetOddChipOrder(g.SeatsFromButton())
+
 	r.Calculate()
 
+	// Dead blinds were put into the main pot without a level, so that they are charged separately
+	for _, p := range g.gs.Players {
+		if p.DeadBlind > 0 && len(r.Pots) > 0 {
+			r.Update(0, p.Idx, p.DeadBlind, -p.DeadBlind)
+		}
+	}
+
+	// Players who cashed out are paid by equity rather than by winnings they sold
+	for _, p := range g.gs.Players {
+		if p.CashOutFraction > 0 {
+			r.CashOut(p.Idx, p.CashOut, p.CashOutFraction)
+		}
+	}
+
+	// Cards for dispute resolution
+	r.Board = append(r.Board, g.gs.Status.Board...)
+	r.Burned = append(r.Burned, g.gs.Status.Burned...)
+
 	// Update state
 	g.gs.Result = r
 
@@ -5,7 +5,10 @@ type PotResult struct {
 	level *PotLevel
 
 	Total   int64     `json:"total"`
+	Rake    int64     `json:"rake,omitempty"` // taken from pot before total was distributed
 	Winners []*Winner `json:"winners"`
+	Chopped bool      `json:"chopped,omitempty"` // pot was split by multiple winners who tied
+	Run     int       `json:"run,omitempty"`     // index of run if board was run multiple times
 }
 
 type Winner struct {
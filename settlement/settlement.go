@@ -1,24 +1,35 @@
 package settlement
 
 import (
+	"sort"
+
 	"github.com/d-protocol/pokerlib/pot"
 )
 
 type Result struct {
 	Players []*PlayerResult `json:"players"`
 	Pots    []*PotResult    `json:"pots"`
+	Board   []string        `json:"board,omitempty"`
+	Burned  []string        `json:"burned,omitempty"` // in order of burning
+
+	oddChipOrder []int
 }
 
 type PlayerResult struct {
-	Idx     int   `json:"idx"`
-	Final   int64 `json:"final"`
-	Changed int64 `json:"changed"`
+	Idx         int   `json:"idx"`
+	Final       int64 `json:"final"`
+	Changed     int64 `json:"changed"`
+	UncalledBet int64 `json:"uncalled_bet,omitempty"`
+	CashOut     int64 `json:"cash_out,omitempty"` // paid by equity before settlement
+	Sold        int64 `json:"sold,omitempty"`     // winnings which go to the insurer
 }
 
 func NewResult() *Result {
 	return &Result{
 		Players: make([]*PlayerResult, 0),
 		Pots:    make([]*PotResult, 0),
+		Board:   make([]string, 0),
+		Burned:  make([]string, 0),
 	}
 }
 
@@ -48,6 +59,75 @@ func (r *Result) AddPot(total int64, levels []*pot.Level) {
 	r.Pots = append(r.Pots, pr)
 }
 
+// AddUncalledBet records the uncalled bet which was returned to player before pot distribution.
+func (r *Result) AddUncalledBet(playerIdx int, chips int64) {
+
+	for _, p := range r.Players {
+		if p.Idx == playerIdx {
+			p.UncalledBet += chips
+			return
+		}
+	}
+}
+
+// CashOut pays player chips of equity and takes the fraction of winnings which was sold, it should
+// be called after pots were calculated.
+func (r *Result) CashOut(playerIdx int, payout int64, fraction float64) {
+
+	won := int64(0)
+	for _, pot := range r.Pots {
+		for _, w := range pot.Winners {
+			if w.Idx == playerIdx {
+				won += w.Withdraw
+			}
+		}
+	}
+
+	sold := int64(float64(won) * fraction)
+
+	for _, p := range r.Players {
+		if p.Idx == playerIdx {
+			p.CashOut += payout
+			p.Sold += sold
+			p.Final += payout - sold
+			p.Changed += payout - sold
+			return
+		}
+	}
+}
+
+// SetOddChipOrder sets the order of players to receive odd chips when a pot cannot be split evenly,
+// which is usually starting from the first player left of the dealer. Winners who are not in the
+// order, or if no order was set, receive odd chips by ascending player index.
+func (r *Result) SetOddChipOrder(playerIdxs []int) {
+	r.oddChipOrder = playerIdxs
+}
+
+func (r *Result) sortByOddChipOrder(playerIdxs []int) []int {
+
+	orders := make(map[int]int, len(r.oddChipOrder))
+	for i, idx := range r.oddChipOrder {
+		orders[idx] = i
+	}
+
+	order := func(idx int) int {
+		if o, ok := orders[idx]; ok {
+			return o
+		}
+
+		return len(r.oddChipOrder) + idx
+	}
+
+	sorted := make([]int, len(playerIdxs))
+	copy(sorted, playerIdxs)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return order(sorted[i]) < order(sorted[j])
+	})
+
+	return sorted
+}
+
 func (r *Result) UpdateScore(playerIdx int, score int) {
 
 	for _, p := range r.Pots {
@@ -57,12 +137,25 @@ func (r *Result) UpdateScore(playerIdx int, score int) {
 	}
 }
 
+// UpdatePotScore updates score of player for the specific pot only, which is for pots that are
+// settled with different boards.
+func (r *Result) UpdatePotScore(potIdx int, playerIdx int, score int) {
+
+	if potIdx < 0 || potIdx >= len(r.Pots) {
+		return
+	}
+
+	for _, l := range r.Pots[potIdx].level.levels {
+		l.UpdateScore(playerIdx, score)
+	}
+}
+
 func (r *Result) Update(potIdx int, playerIdx int, wager int64, withdraw int64) {
 
 	pot := r.Pots[potIdx]
 
-	// Update winners information
-	if withdraw > 0 {
+	// Update winners information, winner of a chopped pot might get less than wager back after rake
+	if withdraw+wager > 0 {
 		pot.UpdateWinner(playerIdx, withdraw+wager)
 	}
 
@@ -82,9 +175,12 @@ func (r *Result) CalculateWinnerRewards(potIdx int, l *LevelInfo) {
 	l.rank.Calculate()
 
 	// Calculate chips for multiple winners of this pot
-	winners := l.rank.GetWinners()
+	winners := r.sortByOddChipOrder(l.rank.GetWinners())
+	if len(winners) > 1 {
+		r.Pots[potIdx].Chopped = true
+	}
 
-	// Calculate rewards
+	// Calculate rewards, odd chips go to winners in order one by one
 	based := l.Total / int64(len(winners))
 	remainder := l.Total % int64(len(winners))
 
@@ -315,3 +315,50 @@ func TestMultipleWinnersWithRemainder(t *testing.T) {
 	assert.Equal(t, int64(555), r.Players[1].Changed)
 	assert.Equal(t, int64(-1111), r.Players[2].Changed)
 }
+
+func TestOddChip(t *testing.T) {
+
+	newResult := func() *Result {
+
+		r := NewResult()
+
+		// Player 1 folded after posting 1 chip, so that pot has 101 chips
+		ll := pot.NewLevelList()
+		ll.AddContributor(50, 0, false)
+		ll.AddContributor(1, 1, true)
+		ll.AddContributor(50, 2, false)
+
+		for idx := 0; idx < 3; idx++ {
+			r.AddPlayer(idx, 1000)
+		}
+
+		for _, p := range ll.GetPots() {
+			r.AddPot(p.Total, p.Levels)
+		}
+
+		// Player 0 and player 2 split the pot
+		r.UpdateScore(0, 1000)
+		r.UpdateScore(1, 0)
+		r.UpdateScore(2, 1000)
+
+		return r
+	}
+
+	r := newResult()
+	assert.Equal(t, 1, len(r.Pots))
+	assert.Equal(t, int64(101), r.Pots[0].Total)
+
+	// Odd chip goes to the lowest player index if no order was set
+	r.Calculate()
+	assert.Equal(t, int64(1001), r.Players[0].Final)
+	assert.Equal(t, int64(999), r.Players[1].Final)
+	assert.Equal(t, int64(1000), r.Players[2].Final)
+
+	// Odd chip goes to the first winner left of dealer (player 0 is the dealer)
+	r = newResult()
+	r.SetOddChipOrder([]int{1, 2, 0})
+	r.Calculate()
+	assert.Equal(t, int64(1000), r.Players[0].Final)
+	assert.Equal(t, int64(999), r.Players[1].Final)
+	assert.Equal(t, int64(1001), r.Players[2].Final)
+}
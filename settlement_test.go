@@ -0,0 +1,418 @@
+package pokerlib
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Settlement_UncalledBet(t *testing.T) {
+
+	g := startTestGame(t, newTestGameOptions(10000, 10000, 10000))
+
+	// Preflop
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Check())
+
+	// Flop, turn
+	for _, round := range []string{"flop", "turn"} {
+		assert.Equal(t, round, g.GetState().Status.Round)
+		assert.Nil(t, g.ReadyForAll())
+		assert.Nil(t, g.Check())
+		assert.Nil(t, g.Check())
+		assert.Nil(t, g.Check())
+	}
+
+	// River: a big bet is folded to
+	assert.Equal(t, "river", g.GetState().Status.Round)
+	assert.Nil(t, g.ReadyForAll())
+	bettor := g.GetCurrentPlayer().State().Idx
+	assert.Nil(t, g.Bet(5000))
+	assert.Nil(t, g.Fold())
+	assert.Nil(t, g.Fold())
+
+	gs := g.GetState()
+	assert.Equal(t, "GameClosed", gs.Status.CurrentEvent)
+	assert.Equal(t, int64(5000), gs.GetPlayer(bettor).UncalledBet)
+
+	// Only called chips were placed in pots
+	total := int64(0)
+	for _, pot := range gs.Result.Pots {
+		total += pot.Total
+	}
+	assert.Equal(t, int64(30), total)
+
+	for _, rs := range gs.Result.Players {
+		if rs.Idx == bettor {
+			assert.Equal(t, int64(5000), rs.UncalledBet)
+			assert.Equal(t, int64(10020), rs.Final)
+			assert.Equal(t, int64(20), rs.Changed)
+		} else {
+			assert.Equal(t, int64(0), rs.UncalledBet)
+			assert.Equal(t, int64(9990), rs.Final)
+		}
+	}
+}
+
+func Test_Settlement_BurnedCards(t *testing.T) {
+
+	g := startTestGame(t, newTestGameOptions(10000, 10000, 10000))
+	assert.Empty(t, g.BurnedCards())
+
+	// Preflop
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Check())
+
+	// Flop, turn and river
+	streets := 0
+	for g.GetEvent() != "GameClosed" {
+		streets++
+		assert.Nil(t, g.ReadyForAll())
+		assert.Nil(t, g.Check())
+		assert.Nil(t, g.Check())
+		assert.Nil(t, g.Check())
+	}
+
+	gs := g.GetState()
+	assert.Equal(t, 3, streets)
+	assert.Len(t, g.BurnedCards(), streets*gs.Meta.BurnCount)
+	assert.Equal(t, gs.Status.Burned, gs.Result.Burned)
+	assert.Equal(t, gs.Status.Board, gs.Result.Board)
+	assert.Len(t, gs.Result.Board, 5)
+}
+
+func Test_Settlement_Chopped(t *testing.T) {
+
+	// Board plays
+	opts := newTestGameOptions(10000, 10000, 10000)
+	opts.PresetBoard = []string{"HA", "HK", "HQ", "HJ", "HT"}
+	opts.Players[1].PresetHoleCards = []string{"S2", "D3"}
+	opts.Players[2].PresetHoleCards = []string{"C4", "S5"}
+
+	g := startTestGame(t, opts)
+
+	// Preflop
+	assert.Nil(t, g.Fold())
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Check())
+
+	for g.GetEvent() != "GameClosed" {
+		assert.Nil(t, g.ReadyForAll())
+		assert.Nil(t, g.Check())
+		assert.Nil(t, g.Check())
+		assert.Nil(t, g.Pass())
+	}
+
+	gs := g.GetState()
+	assert.Len(t, gs.Result.Pots, 1)
+	assert.True(t, gs.Result.Pots[0].Chopped)
+	assert.True(t, g.IsChopped(0))
+	assert.False(t, g.IsChopped(1))
+
+	// Winners get their wagers back
+	winners := gs.Result.Pots[0].Winners
+	assert.Len(t, winners, 2)
+	for _, w := range winners {
+		assert.Contains(t, []int{1, 2}, w.Idx)
+		assert.Equal(t, int64(10), w.Withdraw)
+	}
+
+	for _, rs := range gs.Result.Players {
+		if rs.Idx == 0 {
+			continue
+		}
+
+		assert.Equal(t, int64(10000), rs.Final)
+		assert.Equal(t, int64(0), rs.Changed)
+	}
+}
+
+func Test_Settlement_SeatsFromButton(t *testing.T) {
+
+	opts := newTestGameOptions(10000, 10000, 10000, 10000)
+	opts.Players[0].Positions = []string{"bb"}
+	opts.Players[1].Positions = []string{}
+	opts.Players[2].Positions = []string{"dealer"}
+	opts.Players[3].Positions = []string{"sb"}
+
+	g := NewGame(opts)
+	assert.Nil(t, g.Start())
+	assert.Equal(t, []int{3, 0, 1, 2}, g.SeatsFromButton())
+}
+
+func Test_Settlement_OddChip(t *testing.T) {
+
+	// Board plays
+	opts := newTestGameOptions(10000, 10000, 10000)
+	opts.PresetBoard = []string{"HA", "HK", "HQ", "HJ", "HT"}
+	opts.Players[0].PresetHoleCards = []string{"S2", "D3"}
+	opts.Players[2].PresetHoleCards = []string{"C4", "S5"}
+
+	g := startTestGame(t, opts)
+
+	// Preflop, pot is 25 with small blind folded
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Fold())
+	assert.Nil(t, g.Check())
+
+	for g.GetEvent() != "GameClosed" {
+		assert.Nil(t, g.ReadyForAll())
+		assert.Nil(t, g.Pass())
+		assert.Nil(t, g.Check())
+		assert.Nil(t, g.Check())
+	}
+
+	// Odd chip goes to seat 2 which is closer to the left of the button than seat 0
+	assert.Equal(t, []int{1, 2, 0}, g.SeatsFromButton())
+
+	gs := g.GetState()
+	assert.True(t, gs.Result.Pots[0].Chopped)
+	assert.Len(t, gs.Result.Pots[0].Winners, 2)
+	for _, w := range gs.Result.Pots[0].Winners {
+		switch w.Idx {
+		case 0:
+			assert.Equal(t, int64(12), w.Withdraw)
+		case 2:
+			assert.Equal(t, int64(13), w.Withdraw)
+		}
+	}
+}
+
+func Test_Settlement_Rake(t *testing.T) {
+
+	opts := newTestGameOptions(10000, 10000, 10000)
+	opts.Rake = RakeSetting{BasisPoints: 1000}
+	opts.PresetBoard = []string{"S2", "D7", "C9", "HJ", "D3"}
+	opts.Players[0].PresetHoleCards = []string{"SA", "HA"}
+	opts.Players[1].PresetHoleCards = []string{"SK", "HK"}
+	opts.Players[2].PresetHoleCards = []string{"C4", "H5"}
+
+	g := startTestGame(t, opts)
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Check())
+	assert.Equal(t, int64(27), g.PotAfterRake())
+	playToShowdown(t, g)
+
+	// Winner is paid the pot after rake
+	r := g.GetState().Result
+	assert.Equal(t, int64(27), r.Pots[0].Total)
+	assert.Equal(t, int64(3), r.Pots[0].Rake)
+
+	changed := int64(0)
+	for _, rs := range r.Players {
+		changed += rs.Changed
+		if rs.Idx == 0 {
+			assert.Equal(t, int64(17), rs.Changed)
+		} else {
+			assert.Equal(t, int64(-10), rs.Changed)
+		}
+	}
+	assert.Equal(t, int64(-3), changed)
+
+	history, err := g.ExportHandHistory()
+	assert.Nil(t, err)
+	assert.Contains(t, history, "Total pot 30 | Rake 3\n")
+}
+
+func Test_Settlement_Rake_Chopped(t *testing.T) {
+
+	opts := newTestGameOptions(10000, 10000, 10000)
+	opts.Rake = RakeSetting{BasisPoints: 1000}
+	opts.PresetBoard = []string{"SA", "SK", "SQ", "SJ", "ST"}
+
+	g := startTestGame(t, opts)
+	assert.Nil(t, g.Fold())
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Check())
+	playToShowdown(t, g)
+
+	// Board plays, both winners get less than wager back after rake
+	r := g.GetState().Result
+	assert.True(t, r.Pots[0].Chopped)
+	assert.Equal(t, int64(2), r.Pots[0].Rake)
+	assert.Len(t, r.Pots[0].Winners, 2)
+	for _, w := range r.Pots[0].Winners {
+		assert.Contains(t, []int{1, 2}, w.Idx)
+		assert.Equal(t, int64(9), w.Withdraw)
+	}
+
+	for _, rs := range r.Players {
+		if rs.Idx != 0 {
+			assert.Equal(t, int64(-1), rs.Changed)
+		}
+	}
+
+	history, err := g.ExportHandHistory()
+	assert.Nil(t, err)
+	assert.Contains(t, history, "Player2 collected 9 from pot\n")
+	assert.Contains(t, history, "Player3 collected 9 from pot\n")
+}
+
+func Test_Settlement_KillPot(t *testing.T) {
+
+	opts := newTestGameOptions(10000, 10000, 10000)
+	opts.Limit = "limit"
+	opts.KillPot.Threshold = 100
+	opts.PresetBoard = []string{"S2", "D7", "C9", "HJ", "D3"}
+	opts.Players[0].PresetHoleCards = []string{"SA", "HA"}
+	opts.Players[1].PresetHoleCards = []string{"SK", "HK"}
+
+	g := startTestGame(t, opts)
+	assert.Equal(t, -1, g.KillPotWinner())
+
+	// Preflop
+	assert.Nil(t, g.Raise(50))
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Fold())
+
+	for g.GetEvent() != "GameClosed" {
+		assert.Nil(t, g.ReadyForAll())
+		for g.GetEvent() == "RoundStarted" {
+			if g.GetCurrentPlayer().CheckAction("pass") {
+				assert.Nil(t, g.Pass())
+			} else {
+				assert.Nil(t, g.Check())
+			}
+		}
+	}
+
+	// Pot is large enough to be killed
+	assert.Equal(t, int64(110), g.GetState().Result.Pots[0].Total)
+	assert.Equal(t, 0, g.KillPotWinner())
+
+	// Stakes double for the next hand and the winner posts kill blind
+	opts.Players[0].Killed = true
+	g = startTestGame(t, opts)
+
+	gs := g.GetState()
+	assert.Equal(t, int64(10), gs.Meta.Blind.SB)
+	assert.Equal(t, int64(20), gs.Meta.Blind.BB)
+	assert.Equal(t, int64(20), gs.GetPlayer(0).Wager)
+	assert.Equal(t, int64(10), gs.GetPlayer(1).Wager)
+	assert.Equal(t, int64(20), gs.GetPlayer(2).Wager)
+	assert.Equal(t, int64(20), gs.Status.MiniBet)
+
+	killed := false
+	for _, a := range gs.Status.ActionHistory {
+		if a.Type == "kill_blind" {
+			assert.Equal(t, 0, a.Source)
+			assert.Equal(t, int64(20), a.Value)
+			killed = true
+		}
+	}
+	assert.True(t, killed)
+
+	// Player can be killed only if kill pot is enabled
+	opts.KillPot.Threshold = 0
+	assert.Equal(t, ErrInvalidGameConfig, opts.Validate())
+}
+
+func Test_Settlement_KillPot_NewHand(t *testing.T) {
+
+	opts := newTestGameOptions(10000, 10000, 10000)
+	opts.Limit = "limit"
+	opts.KillPot.Threshold = 100
+
+	positions := map[int][]string{
+		0: {"dealer"},
+		1: {"sb"},
+		2: {"bb"},
+	}
+
+	// Dealer takes a pot large enough to be killed
+	opts.PresetBoard = []string{"S2", "D7", "C9", "HJ", "D3"}
+	opts.Players[0].PresetHoleCards = []string{"SA", "HA"}
+	opts.Players[1].PresetHoleCards = []string{"SK", "HK"}
+	opts.Players[2].PresetHoleCards = []string{"C4", "H5"}
+
+	g := startTestGame(t, opts)
+	assert.Nil(t, g.Raise(50))
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Call())
+	playToShowdown(t, g)
+	assert.Equal(t, 0, g.KillPotWinner())
+
+	// Stakes double for the next hand and the winner posts kill blind
+	assert.Nil(t, g.NewHand(NewStandardDeckCards(), positions))
+	gs := g.GetState()
+	assert.True(t, gs.GetPlayer(0).Killed)
+	assert.Equal(t, int64(10), gs.Meta.Blind.SB)
+	assert.Equal(t, int64(20), gs.Meta.Blind.BB)
+
+	assert.Nil(t, g.Start())
+	assert.Nil(t, g.ReadyForAll())
+	assert.Nil(t, g.PayBlinds())
+	assert.Nil(t, g.ReadyForAll())
+	assert.Equal(t, int64(20), g.GetState().GetPlayer(0).Wager)
+
+	// Clone of the killed hand goes back to normal stakes as well
+	c := g.Clone().(*game)
+
+	// Small pot is not killed, so that stakes are back to normal
+	for _, hand := range []*game{g, c} {
+		playToShowdown(t, hand)
+		assert.Equal(t, -1, hand.KillPotWinner())
+
+		assert.Nil(t, hand.NewHand(NewStandardDeckCards(), positions))
+		gs = hand.GetState()
+		assert.False(t, gs.GetPlayer(0).Killed)
+		assert.Equal(t, int64(5), gs.Meta.Blind.SB)
+		assert.Equal(t, int64(10), gs.Meta.Blind.BB)
+	}
+}
+
+func Test_Settlement_FoldToBigBlind(t *testing.T) {
+
+	g := startTestGame(t, newTestGameOptions(10000, 10000, 10000, 10000))
+
+	// Preflop: everyone folds to big blind
+	for _, idx := range []int{3, 0, 1} {
+		assert.Equal(t, idx, g.GetCurrentPlayer().SeatIndex())
+		assert.Nil(t, g.Fold())
+	}
+
+	gs := g.GetState()
+	assert.Equal(t, "GameClosed", gs.Status.CurrentEvent)
+	assert.True(t, g.IsHandComplete())
+	assert.Empty(t, gs.Status.Board)
+
+	for _, rs := range gs.Result.Players {
+		switch rs.Idx {
+		case 2:
+			assert.Equal(t, int64(10005), rs.Final)
+			assert.Equal(t, int64(5), rs.Changed)
+		case 1:
+			assert.Equal(t, int64(9995), rs.Final)
+		default:
+			assert.Equal(t, int64(10000), rs.Final)
+		}
+	}
+}
+
+func Test_Settlement_ForceFoldEveryone(t *testing.T) {
+
+	g := NewGame(newTestGameOptions(10000, 10000, 10000))
+	assert.Nil(t, g.Start())
+	assert.Nil(t, g.ReadyForAll())
+	assert.Nil(t, g.PayBlinds())
+
+	// Everyone is folded before preflop is started, but the last player is never folded so that
+	// the pot still has a winner
+	assert.Nil(t, g.ForceFold(0))
+	assert.Nil(t, g.ForceFold(1))
+	assert.Nil(t, g.ForceFold(2))
+	assert.False(t, g.Player(2).State().Fold)
+	assert.True(t, g.Player(2).State().SitOut)
+
+	assert.Nil(t, g.ReadyForAll())
+	assert.Equal(t, "GameClosed", g.GetEvent())
+
+	for _, rs := range g.GetState().Result.Players {
+		if rs.Idx == 2 {
+			assert.Equal(t, int64(10005), rs.Final)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package pokerlib
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+// Shuffler returns shuffled cards without modifying the input slice.
+type Shuffler func(cards []string) []string
+
+// RiffleShuffle simulates physical riffle shuffles with the Gilbert-Shannon-Reeds model. The deck is
+// cut near the middle, then cards drop from both halves with probability proportional to the size
+// of each half. It is imperfect by design: a few rounds leave the original order detectable, so it
+// is for simulation and research only and must never be used for real games.
+func RiffleShuffle(cards []string, rounds int) []string {
+
+	rnd := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	result := make([]string, len(cards))
+	copy(result, cards)
+
+	for r := 0; r < rounds; r++ {
+
+		// Cut point follows binomial distribution
+		cut := 0
+		for i := 0; i < len(result); i++ {
+			if rnd.Intn(2) == 0 {
+				cut++
+			}
+		}
+
+		left := make([]string, cut)
+		right := make([]string, len(result)-cut)
+		copy(left, result[:cut])
+		copy(right, result[cut:])
+
+		// Interleave halves
+		for i := range result {
+			if len(right) == 0 || (len(left) > 0 && rnd.Intn(len(left)+len(right)) < len(left)) {
+				result[i] = left[0]
+				left = left[1:]
+				continue
+			}
+
+			result[i] = right[0]
+			right = right[1:]
+		}
+	}
+
+	return result
+}
+
+// OverhandShuffle simulates physical overhand shuffles. Small packets are repeatedly taken from the
+// top of deck and dropped onto a new pile, which reverses the order of packets but keeps cards in
+// each packet together. It is even weaker than riffle shuffle, so it is for simulation and research
+// only and must never be used for real games.
+func OverhandShuffle(cards []string, rounds int) []string {
+
+	rnd := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	result := make([]string, len(cards))
+	copy(result, cards)
+
+	// Average packet size is about 1/8 of deck
+	maxPacket := len(result)/4 + 1
+
+	for r := 0; r < rounds; r++ {
+
+		pile := make([]string, len(result))
+		bottom := len(pile)
+
+		for top := 0; top < len(result); {
+
+			size := rnd.Intn(maxPacket) + 1
+			if size > len(result)-top {
+				size = len(result) - top
+			}
+
+			// Packet is dropped on top of the pile
+			copy(pile[bottom-size:bottom], result[top:top+size])
+			bottom -= size
+			top += size
+		}
+
+		result = pile
+	}
+
+	return result
+}
+
+// NewRiffleShuffler returns a shuffler which runs riffle shuffle for specific rounds.
+func NewRiffleShuffler(rounds int) Shuffler {
+	return func(cards []string) []string {
+		return RiffleShuffle(cards, rounds)
+	}
+}
+
+// NewOverhandShuffler returns a shuffler which runs overhand shuffle for specific rounds.
+func NewOverhandShuffler(rounds int) Shuffler {
+	return func(cards []string) []string {
+		return OverhandShuffle(cards, rounds)
+	}
+}
+
+// ShuffleCardsWithSeed shuffles cards with Fisher-Yates by a pseudo-random source of seed, and cuts
+// cards by the same source if cut is true. The same seed always results in the same order, so that
+// deck of a hand can be reproduced for dispute resolution. Seed has to be kept secret until the hand
+// is over, otherwise cards are predictable.
+func ShuffleCardsWithSeed(cards []string, seed int64, cut bool) []string {
+
+	rnd := rand.New(rand.NewSource(seed))
+
+	result := make([]string, len(cards))
+	copy(result, cards)
+
+	for i := len(result) - 1; i > 0; i-- {
+		j := rnd.Intn(i + 1)
+		result[i], result[j] = result[j], result[i]
+	}
+
+	// Cut point should leave at least one card at both parts
+	if cut && len(result) >= 2 {
+		result = CutCards(result, rnd.Intn(len(result)-1)+1)
+	}
+
+	return result
+}
+
+// DeckFingerprint returns a hash of order of cards, which is recorded to verify a deck afterward
+// when it cannot be reproduced by seed.
+func DeckFingerprint(cards []string) string {
+	sum := sha256.Sum256([]byte(strings.Join(cards, ",")))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,130 @@
+package pokerlib
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// risingSequences counts rising sequences of original positions, which is at most 2^rounds after
+// riffle shuffles.
+func risingSequences(original []string, shuffled []string) int {
+
+	positions := make(map[string]int, len(shuffled))
+	for i, c := range shuffled {
+		positions[c] = i
+	}
+
+	count := 1
+	for i := 1; i < len(original); i++ {
+		if positions[original[i]] < positions[original[i-1]] {
+			count++
+		}
+	}
+
+	return count
+}
+
+// adjacentPairs counts cards which are still followed by the same card.
+func adjacentPairs(original []string, shuffled []string) int {
+
+	next := make(map[string]string, len(original))
+	for i := 1; i < len(original); i++ {
+		next[original[i-1]] = original[i]
+	}
+
+	count := 0
+	for i := 1; i < len(shuffled); i++ {
+		if next[shuffled[i-1]] == shuffled[i] {
+			count++
+		}
+	}
+
+	return count
+}
+
+func TestRiffleShuffle(t *testing.T) {
+
+	cards := NewStandardDeckCards()
+
+	for i := 0; i < 100; i++ {
+		shuffled := RiffleShuffle(cards, 1)
+		assert.ElementsMatch(t, cards, shuffled)
+
+		// Single riffle leaves at most two rising sequences
+		assert.LessOrEqual(t, risingSequences(cards, shuffled), 2)
+	}
+
+	// Original deck is not modified
+	assert.Equal(t, NewStandardDeckCards(), cards)
+
+	shuffled := NewRiffleShuffler(7)(cards)
+	assert.ElementsMatch(t, cards, shuffled)
+	assert.NotEqual(t, cards, shuffled)
+}
+
+func TestOverhandShuffle(t *testing.T) {
+
+	cards := NewStandardDeckCards()
+
+	for i := 0; i < 100; i++ {
+		shuffled := OverhandShuffle(cards, 1)
+		assert.ElementsMatch(t, cards, shuffled)
+
+		// Most cards are still next to each other because packets are kept together
+		assert.Greater(t, adjacentPairs(cards, shuffled), len(cards)/2)
+	}
+
+	// Original deck is not modified
+	assert.Equal(t, NewStandardDeckCards(), cards)
+
+	shuffled := NewOverhandShuffler(10)(cards)
+	assert.ElementsMatch(t, cards, shuffled)
+	assert.NotEqual(t, cards, shuffled)
+}
+
+func TestGameOptions_Shuffler(t *testing.T) {
+
+	opts := newTestGameOptions(10000, 10000, 10000)
+	opts.Shuffler = func(cards []string) []string {
+		return CutCards(cards, 10)
+	}
+
+	g := startTestGame(t, opts)
+	assert.Equal(t, CutCards(NewStandardDeckCards(), 10), g.GetState().Meta.Deck)
+}
+
+func TestShuffleSeedRecording(t *testing.T) {
+
+	// Seeded deck is reproduced by recorded seed
+	opts := newTestGameOptions(10000, 10000, 10000)
+	opts.ShuffleSeed = 20240101
+	opts.Cut = true
+
+	g := NewGame(opts)
+	assert.Nil(t, g.Start())
+
+	gs := g.GetState()
+	assert.Equal(t, int64(20240101), gs.Meta.ShuffleSeed)
+	assert.Empty(t, gs.Meta.DeckFingerprint)
+	assert.Equal(t, ShuffleCardsWithSeed(NewStandardDeckCards(), gs.Meta.ShuffleSeed, true), gs.Meta.Deck)
+
+	// Seed is private until hand is over
+	public := gs.Clone()
+	public.AsPlayer(0)
+	assert.Zero(t, public.Meta.ShuffleSeed)
+
+	observer := gs.Clone()
+	observer.AsObserver()
+	assert.Zero(t, observer.Meta.ShuffleSeed)
+	assert.Equal(t, int64(20240101), gs.Meta.ShuffleSeed)
+
+	// Deck of secure shuffling is verified by fingerprint
+	g = NewGame(newTestGameOptions(10000, 10000, 10000))
+	assert.Nil(t, g.Start())
+
+	gs = g.GetState()
+	assert.Zero(t, gs.Meta.ShuffleSeed)
+	assert.Equal(t, DeckFingerprint(gs.Meta.Deck), gs.Meta.DeckFingerprint)
+	assert.NotEqual(t, DeckFingerprint(NewStandardDeckCards()), gs.Meta.DeckFingerprint)
+}
@@ -0,0 +1,74 @@
+package pokerlib
+
+import (
+	"errors"
+	"sync"
+)
+
+var (
+	ErrStateNotFound = errors.New("store: state not found")
+	ErrNoGameID      = errors.New("store: no game id")
+)
+
+// StateStore persists game states keyed by game ID so that in-progress hands can be reloaded.
+type StateStore interface {
+	Save(gs *GameState) error
+	Load(gameID string) (*GameState, error)
+	Delete(gameID string) error
+}
+
+type memoryStateStore struct {
+	states map[string]*GameState
+	mu     sync.RWMutex
+}
+
+// NewMemoryStateStore returns a store which keeps copies of states in memory, which is safe for
+// concurrent use.
+func NewMemoryStateStore() StateStore {
+	return &memoryStateStore{
+		states: make(map[string]*GameState),
+	}
+}
+
+func (s *memoryStateStore) Save(gs *GameState) error {
+
+	if gs.GameID == "" {
+		return ErrNoGameID
+	}
+
+	state := gs.Clone()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.states[gs.GameID] = state
+
+	return nil
+}
+
+func (s *memoryStateStore) Load(gameID string) (*GameState, error) {
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	gs, ok := s.states[gameID]
+	if !ok {
+		return nil, ErrStateNotFound
+	}
+
+	return gs.Clone(), nil
+}
+
+func (s *memoryStateStore) Delete(gameID string) error {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.states[gameID]; !ok {
+		return ErrStateNotFound
+	}
+
+	delete(s.states, gameID)
+
+	return nil
+}
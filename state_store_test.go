@@ -0,0 +1,40 @@
+package pokerlib
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_StateStore_Memory(t *testing.T) {
+
+	store := NewMemoryStateStore()
+
+	g := startTestGame(t, newTestGameOptions(10000, 10000, 10000))
+	g.GetState().GameID = "game_1"
+	assert.Nil(t, g.Raise(30))
+	assert.Nil(t, store.Save(g.GetState()))
+
+	// Stored state is not affected by the live game
+	checksum := g.GetState().Checksum()
+	assert.Nil(t, g.Call())
+
+	gs, err := store.Load("game_1")
+	assert.Nil(t, err)
+	assert.Equal(t, checksum, gs.Checksum())
+
+	// Resume the hand from stored state
+	restored := NewGameFromState(gs)
+	assert.Nil(t, restored.Call())
+	assert.Nil(t, restored.Call())
+	assert.Equal(t, "flop", restored.GetState().Status.Round)
+	assert.Equal(t, int64(30), restored.GetState().Players[0].Pot)
+
+	assert.Nil(t, store.Delete("game_1"))
+	_, err = store.Load("game_1")
+	assert.ErrorIs(t, err, ErrStateNotFound)
+	assert.ErrorIs(t, store.Delete("game_1"), ErrStateNotFound)
+
+	g.GetState().GameID = ""
+	assert.ErrorIs(t, store.Save(g.GetState()), ErrNoGameID)
+}
@@ -0,0 +1,54 @@
+package pokerlib
+
+// StateSummary is a lightweight snapshot of game state for clients which poll frequently.
+type StateSummary struct {
+	GameID        string        `json:"game_id"`
+	UpdatedAt     int64         `json:"updated_at"`
+	Event         string        `json:"event"`
+	Round         string        `json:"round"`
+	CurrentPlayer int           `json:"current_player"`
+	PotTotal      int64         `json:"pot_total"`
+	Board         []string      `json:"board"`
+	Seats         []SeatSummary `json:"seats"`
+}
+
+type SeatSummary struct {
+	Idx       int   `json:"idx"`
+	StackSize int64 `json:"stack_size"`
+	Wager     int64 `json:"wager"`
+	Fold      bool  `json:"fold"`
+}
+
+// Summary returns the essentials of state. Pot total includes wagers of the current round.
+func (gs *GameState) Summary() StateSummary {
+
+	s := StateSummary{
+		GameID:        gs.GameID,
+		UpdatedAt:     gs.UpdatedAt,
+		Event:         gs.Status.CurrentEvent,
+		Round:         gs.Status.Round,
+		CurrentPlayer: gs.Status.CurrentPlayer,
+		Board:         make([]string, len(gs.Status.Board)),
+		Seats:         make([]SeatSummary, 0, len(gs.Players)),
+	}
+
+	copy(s.Board, gs.Status.Board)
+
+	for _, p := range gs.Players {
+
+		s.PotTotal += p.Pot + p.Wager
+
+		s.Seats = append(s.Seats, SeatSummary{
+			Idx:       p.Idx,
+			StackSize: p.StackSize,
+			Wager:     p.Wager,
+			Fold:      p.Fold,
+		})
+	}
+
+	return s
+}
+
+func (g *game) Summary() StateSummary {
+	return g.gs.Summary()
+}
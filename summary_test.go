@@ -0,0 +1,42 @@
+package pokerlib
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Summary(t *testing.T) {
+
+	g := startTestGame(t, newTestGameOptions(10000, 10000, 10000))
+	assert.Nil(t, g.Raise(30))
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Fold())
+
+	// Flop
+	assert.Nil(t, g.ReadyForAll())
+	assert.Nil(t, g.Bet(50))
+
+	gs := g.GetState()
+	s := g.Summary()
+
+	assert.Equal(t, gs.GameID, s.GameID)
+	assert.Equal(t, gs.UpdatedAt, s.UpdatedAt)
+	assert.Equal(t, "RoundStarted", s.Event)
+	assert.Equal(t, "flop", s.Round)
+	assert.Equal(t, gs.Status.CurrentPlayer, s.CurrentPlayer)
+	assert.Equal(t, gs.Status.Board, s.Board)
+	assert.Equal(t, int64(30+30+10+50), s.PotTotal)
+
+	assert.Len(t, s.Seats, len(gs.Players))
+	for i, p := range gs.Players {
+		assert.Equal(t, p.Idx, s.Seats[i].Idx)
+		assert.Equal(t, p.StackSize, s.Seats[i].StackSize)
+		assert.Equal(t, p.Wager, s.Seats[i].Wager)
+		assert.Equal(t, p.Fold, s.Seats[i].Fold)
+	}
+
+	// Summary does not share board with state
+	s.Board[0] = ""
+	assert.NotEqual(t, "", gs.Status.Board[0])
+}
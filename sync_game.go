@@ -0,0 +1,540 @@
+package pokerlib
+
+import (
+	"encoding/json"
+	"sync"
+)
+
+var _ Game = (*SyncGame)(nil)
+
+// SyncGame serializes all calls to the underlying game with a mutex. Player instances returned
+// from it are still bound to the underlying game, so actions should be taken via SyncGame.
+type SyncGame struct {
+	g  Game
+	mu sync.Mutex
+}
+
+func NewSyncGame(g Game) *SyncGame {
+	return &SyncGame{
+		g: g,
+	}
+}
+
+func (sg *SyncGame) ApplyOptions(opts *GameOptions) error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.ApplyOptions(opts)
+}
+
+// Clone returns an independent game which is also synchronized.
+func (sg *SyncGame) Clone() Game {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return NewSyncGame(sg.g.Clone())
+}
+
+func (sg *SyncGame) NewHand(deck []string, positions map[int][]string) error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.NewHand(deck, positions)
+}
+
+func (sg *SyncGame) Start() error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.Start()
+}
+
+func (sg *SyncGame) Resume() error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.Resume()
+}
+
+func (sg *SyncGame) GetEvent() string {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.GetEvent()
+}
+
+// GetState returns a copy of game state so that it can be read without holding the lock.
+func (sg *SyncGame) GetState() *GameState {
+
+	sg.mu.Lock()
+	data, err := sg.g.GetStateJSON()
+	sg.mu.Unlock()
+
+	if err != nil {
+		return nil
+	}
+
+	var gs GameState
+	err = json.Unmarshal(data, &gs)
+	if err != nil {
+		return nil
+	}
+
+	return &gs
+}
+
+func (sg *SyncGame) GetStateJSON() ([]byte, error) {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.GetStateJSON()
+}
+
+func (sg *SyncGame) GetPublicStateJSON(forSeat int) ([]byte, error) {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.GetPublicStateJSON(forSeat)
+}
+
+func (sg *SyncGame) Summary() StateSummary {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.Summary()
+}
+
+func (sg *SyncGame) LoadState(gs *GameState) error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.LoadState(gs)
+}
+
+func (sg *SyncGame) Player(idx int) Player {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.Player(idx)
+}
+
+func (sg *SyncGame) Dealer() Player {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.Dealer()
+}
+
+func (sg *SyncGame) SmallBlind() Player {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.SmallBlind()
+}
+
+func (sg *SyncGame) BigBlind() Player {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.BigBlind()
+}
+
+func (sg *SyncGame) Deal(count int) []string {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.Deal(count)
+}
+
+func (sg *SyncGame) PeekNext(count int) ([]string, error) {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.PeekNext(count)
+}
+
+func (sg *SyncGame) DeckPosition() int {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.DeckPosition()
+}
+
+func (sg *SyncGame) SetDeckPosition(pos int) error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.SetDeckPosition(pos)
+}
+
+func (sg *SyncGame) BurnedCards() []string {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.BurnedCards()
+}
+
+func (sg *SyncGame) Board() []string {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.Board()
+}
+
+func (sg *SyncGame) Flop() []string {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.Flop()
+}
+
+func (sg *SyncGame) Turn() string {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.Turn()
+}
+
+func (sg *SyncGame) River() string {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.River()
+}
+
+func (sg *SyncGame) RabbitHunt() ([]string, error) {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.RabbitHunt()
+}
+
+func (sg *SyncGame) ShowCard(idx int, cardIndex int) error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.ShowCard(idx, cardIndex)
+}
+
+func (sg *SyncGame) ExtendPlayerTime(idx int, ms int64) error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.ExtendPlayerTime(idx, ms)
+}
+
+func (sg *SyncGame) Burn(count int) error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.Burn(count)
+}
+
+func (sg *SyncGame) BecomeRaiser(p Player) error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.BecomeRaiser(p)
+}
+
+func (sg *SyncGame) ResetActedPlayers() error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.ResetActedPlayers()
+}
+
+func (sg *SyncGame) ResetAllPlayerStatus() error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.ResetAllPlayerStatus()
+}
+
+func (sg *SyncGame) StartAtDealer() (Player, error) {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.StartAtDealer()
+}
+
+func (sg *SyncGame) GetPlayerCount() int {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.GetPlayerCount()
+}
+
+func (sg *SyncGame) GetPlayers() []Player {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.GetPlayers()
+}
+
+func (sg *SyncGame) SetCurrentPlayer(p Player) error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.SetCurrentPlayer(p)
+}
+
+func (sg *SyncGame) GetCurrentPlayer() Player {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.GetCurrentPlayer()
+}
+
+func (sg *SyncGame) IsHandComplete() bool {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.IsHandComplete()
+}
+
+func (sg *SyncGame) IsRoundClosed() bool {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.IsRoundClosed()
+}
+
+func (sg *SyncGame) GetAllowedActions(p Player) []string {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.GetAllowedActions(p)
+}
+
+func (sg *SyncGame) GetAvailableActions(p Player) []string {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.GetAvailableActions(p)
+}
+
+func (sg *SyncGame) PreviewActions(idx int) []string {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.PreviewActions(idx)
+}
+
+func (sg *SyncGame) CallAmount(idx int) int64 {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.CallAmount(idx)
+}
+
+func (sg *SyncGame) IsCallAllin(idx int) bool {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.IsCallAllin(idx)
+}
+
+func (sg *SyncGame) HandActionCounts(idx int) ActionCounts {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.HandActionCounts(idx)
+}
+
+func (sg *SyncGame) MinBet() int64 {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.MinBet()
+}
+
+func (sg *SyncGame) MinRaise() int64 {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.MinRaise()
+}
+
+func (sg *SyncGame) GetAlivePlayerCount() int {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.GetAlivePlayerCount()
+}
+
+func (sg *SyncGame) GetMovablePlayerCount() int {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.GetMovablePlayerCount()
+}
+
+func (sg *SyncGame) StackRanking() []int {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.StackRanking()
+}
+
+func (sg *SyncGame) ChipLeader() int {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.ChipLeader()
+}
+
+func (sg *SyncGame) UpdateLastAction(source int, ptype string, value int64) error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.UpdateLastAction(source, ptype, value)
+}
+
+func (sg *SyncGame) EmitEvent(event GameEvent) error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.EmitEvent(event)
+}
+
+func (sg *SyncGame) ExportHandHistory() (string, error) {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.ExportHandHistory()
+}
+
+func (sg *SyncGame) PrintState() error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.PrintState()
+}
+
+func (sg *SyncGame) Pots() []PotView {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.Pots()
+}
+
+func (sg *SyncGame) AllInPlayers() []AllInInfo {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.AllInPlayers()
+}
+
+func (sg *SyncGame) LiveEquities(iterations int) ([]float64, error) {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.LiveEquities(iterations)
+}
+
+func (sg *SyncGame) CashOut(idx int, fraction float64) (int64, error) {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.CashOut(idx, fraction)
+}
+
+func (sg *SyncGame) PotAfterRake() int64 {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.PotAfterRake()
+}
+
+func (sg *SyncGame) IsChopped(potIdx int) bool {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.IsChopped(potIdx)
+}
+
+func (sg *SyncGame) KillPotWinner() int {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.KillPotWinner()
+}
+
+func (sg *SyncGame) SeatsFromButton() []int {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.SeatsFromButton()
+}
+
+func (sg *SyncGame) PrintPots() {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	sg.g.PrintPots()
+}
+
+// Operations
+func (sg *SyncGame) Next() error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.Next()
+}
+
+func (sg *SyncGame) ReadyForAll() error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.ReadyForAll()
+}
+
+func (sg *SyncGame) PayAnte() error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.PayAnte()
+}
+
+func (sg *SyncGame) PayBlinds() error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.PayBlinds()
+}
+
+// Actions
+func (sg *SyncGame) Pass() error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.Pass()
+}
+
+func (sg *SyncGame) Pay(chips int64) error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.Pay(chips)
+}
+
+func (sg *SyncGame) Fold() error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.Fold()
+}
+
+func (sg *SyncGame) Check() error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.Check()
+}
+
+func (sg *SyncGame) Call() error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.Call()
+}
+
+func (sg *SyncGame) Allin() error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.Allin()
+}
+
+func (sg *SyncGame) Bet(chips int64) error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.Bet(chips)
+}
+
+func (sg *SyncGame) Complete() error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.Complete()
+}
+
+func (sg *SyncGame) Raise(chipLevel int64) error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.Raise(chipLevel)
+}
+
+func (sg *SyncGame) RaiseTo(total int64) error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.RaiseTo(total)
+}
+
+func (sg *SyncGame) ForceFold(idx int) error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.ForceFold(idx)
+}
+
+func (sg *SyncGame) MarkDeadHand(idx int) error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.MarkDeadHand(idx)
+}
+
+func (sg *SyncGame) AutoAct(idx int) error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.AutoAct(idx)
+}
+
+func (sg *SyncGame) RaiseBy(amount int64) error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.RaiseBy(amount)
+}
+
+// OnCombinationUpdated registers a callback which is invoked while the lock is held, so it must not
+// call back into the game.
+func (sg *SyncGame) OnCombinationUpdated(fn func(idx int, info *CombinationInfo)) {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	sg.g.OnCombinationUpdated(fn)
+}
+
+// OnDeal registers a callback which is invoked while the lock is held, so it must not call back into
+// the game.
+func (sg *SyncGame) OnDeal(fn func(target string, cards []string)) {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	sg.g.OnDeal(fn)
+}
@@ -0,0 +1,56 @@
+package pokerlib
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SyncGame_Concurrent(t *testing.T) {
+
+	sg := NewSyncGame(NewGame(newTestGameOptions(10000, 10000, 10000)))
+	assert.Nil(t, sg.Start())
+	assert.Nil(t, sg.ReadyForAll())
+	assert.Nil(t, sg.PayBlinds())
+	assert.Nil(t, sg.ReadyForAll())
+
+	var wg sync.WaitGroup
+
+	// Readers
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				gs := sg.GetState()
+				assert.NotNil(t, gs)
+				_, err := sg.GetStateJSON()
+				assert.Nil(t, err)
+				sg.GetEvent()
+			}
+		}()
+	}
+
+	// Actors which try to take action at the same time
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				if sg.Call() != nil {
+					sg.Check()
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	// Everyone called and big blind checked so preflop should be done
+	gs := sg.GetState()
+	assert.NotEqual(t, "preflop", gs.Status.Round)
+	for _, p := range gs.Players {
+		assert.Equal(t, int64(10), p.Pot)
+	}
+}
@@ -0,0 +1,79 @@
+package table
+
+import "github.com/d-protocol/pokerlib/settlement"
+
+// TableEvent is a granular event of table, which allows consumers to react without diffing states.
+type TableEvent interface {
+	EventName() string
+}
+
+type PlayerJoinedEvent struct {
+	TableID  string `json:"table_id"`
+	SeatID   int    `json:"seat_id"`
+	PlayerID string `json:"player_id"`
+}
+
+type PlayerQueuedEvent struct {
+	TableID  string `json:"table_id"`
+	PlayerID string `json:"player_id"`
+	Position int    `json:"position"`
+}
+
+// PlayerLeftEvent is emitted once player was removed from the table, Bankroll is chips the player
+// takes away. Player who leaves in the middle of a hand is removed after the hand was settled.
+type PlayerLeftEvent struct {
+	TableID  string `json:"table_id"`
+	SeatID   int    `json:"seat_id"`
+	PlayerID string `json:"player_id"`
+	Bankroll int64  `json:"bankroll"`
+}
+
+type HandStartedEvent struct {
+	TableID    string `json:"table_id"`
+	GameNumber int    `json:"game_number"`
+}
+
+type ActionTakenEvent struct {
+	TableID  string `json:"table_id"`
+	PlayerID string `json:"player_id"`
+	Action   string `json:"action"`
+	Value    int64  `json:"value"`
+}
+
+type HandSettledEvent struct {
+	TableID    string             `json:"table_id"`
+	GameNumber int                `json:"game_number"`
+	Result     *settlement.Result `json:"result"`
+}
+
+type TableClosedEvent struct {
+	TableID string `json:"table_id"`
+}
+
+func (e *PlayerJoinedEvent) EventName() string {
+	return "PlayerJoined"
+}
+
+func (e *PlayerQueuedEvent) EventName() string {
+	return "PlayerQueued"
+}
+
+func (e *PlayerLeftEvent) EventName() string {
+	return "PlayerLeft"
+}
+
+func (e *HandStartedEvent) EventName() string {
+	return "HandStarted"
+}
+
+func (e *ActionTakenEvent) EventName() string {
+	return "ActionTaken"
+}
+
+func (e *HandSettledEvent) EventName() string {
+	return "HandSettled"
+}
+
+func (e *TableClosedEvent) EventName() string {
+	return "TableClosed"
+}
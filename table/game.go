@@ -88,20 +88,19 @@ func (g *game) handleState(gs *pokerlib.GameState) {
 	case "ReadyRequested":
 
 		// Preparing ready group to wait for all player ready
-		g.rg.Stop()
-		g.rg.OnCompleted(func(rg *syncsaga.ReadyGroup) {
+		rg := syncsaga.NewReadyGroup()
+		rg.OnCompleted(func(rg *syncsaga.ReadyGroup) {
 			g.ReadyForAll()
 		})
 
-		g.rg.ResetParticipants()
 		for _, p := range gs.Players {
-			g.rg.Add(int64(p.Idx), false)
+			rg.Add(int64(p.Idx), false)
 
 			// Allow "ready" action
 			p.AllowAction("ready")
 		}
 
-		g.rg.Start()
+		g.setReadyGroup(rg)
 
 	case "AnteRequested":
 
@@ -110,37 +109,35 @@ func (g *game) handleState(gs *pokerlib.GameState) {
 		}
 
 		// Preparing ready group to wait for ante paid from all player
-		g.rg.Stop()
-		g.rg.OnCompleted(func(rg *syncsaga.ReadyGroup) {
+		rg := syncsaga.NewReadyGroup()
+		rg.OnCompleted(func(rg *syncsaga.ReadyGroup) {
 			g.PayAnte()
 		})
 
-		g.rg.ResetParticipants()
 		for _, p := range gs.Players {
-			g.rg.Add(int64(p.Idx), false)
+			rg.Add(int64(p.Idx), false)
 
 			// Allow "pay" action
 			p.AllowAction("pay")
 		}
 
-		g.rg.Start()
+		g.setReadyGroup(rg)
 
 	case "BlindsRequested":
 
 		// Preparing ready group to wait for blinds
-		g.rg.Stop()
-		g.rg.OnCompleted(func(rg *syncsaga.ReadyGroup) {
+		rg := syncsaga.NewReadyGroup()
+		rg.OnCompleted(func(rg *syncsaga.ReadyGroup) {
 			g.PayBlinds()
 		})
 
-		g.rg.ResetParticipants()
 		for _, p := range gs.Players {
 			if gs.Meta.Blind.BB > 0 && gs.HasPosition(p.Idx, "bb") {
-				g.rg.Add(int64(p.Idx), false)
+				rg.Add(int64(p.Idx), false)
 			} else if gs.Meta.Blind.SB > 0 && gs.HasPosition(p.Idx, "sb") {
-				g.rg.Add(int64(p.Idx), false)
+				rg.Add(int64(p.Idx), false)
 			} else if gs.Meta.Blind.Dealer > 0 && gs.HasPosition(p.Idx, "dealer") {
-				g.rg.Add(int64(p.Idx), false)
+				rg.Add(int64(p.Idx), false)
 			} else {
 				continue
 			}
@@ -149,7 +146,7 @@ func (g *game) handleState(gs *pokerlib.GameState) {
 			p.AllowAction("pay")
 		}
 
-		g.rg.Start()
+		g.setReadyGroup(rg)
 	}
 
 	//fmt.Println("Game Updated =>", g.gs.Status.CurrentEvent)
@@ -157,6 +154,28 @@ func (g *game) handleState(gs *pokerlib.GameState) {
 	g.onStateUpdated(gs)
 }
 
+// setReadyGroup starts rg and replaces the previous ready group, which is
+// stopped rather than reused so its pending validation can't race with new
+// participants.
+func (g *game) setReadyGroup(rg *syncsaga.ReadyGroup) {
+
+	rg.Start()
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.rg.Stop()
+	g.rg = rg
+}
+
+func (g *game) markReady(playerIdx int) {
+
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	g.rg.Ready(int64(playerIdx))
+}
+
 func (g *game) OnStateUpdated(fn func(*pokerlib.GameState)) {
 	g.onStateUpdated = fn
 }
@@ -191,11 +210,14 @@ func (g *game) cloneState(gs *pokerlib.GameState) *pokerlib.GameState {
 
 func (g *game) updateState(gs *pokerlib.GameState) {
 
-	g.mu.RLock()
-	defer g.mu.RUnlock()
-
 	state := g.cloneState(gs)
+
+	g.mu.Lock()
 	g.gs = state
+	g.mu.Unlock()
+
+	g.mu.RLock()
+	defer g.mu.RUnlock()
 
 	if g.isClosed {
 		return
@@ -207,10 +229,18 @@ func (g *game) updateState(gs *pokerlib.GameState) {
 }
 
 func (g *game) GetState() *pokerlib.GameState {
+
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
 	return g.gs
 }
 
 func (g *game) Close() {
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
 	if g.isClosed {
 		return
 	}
@@ -221,22 +251,24 @@ func (g *game) Close() {
 
 func (g *game) Ready(playerIdx int) error {
 
-	if g.gs == nil {
+	state := g.GetState()
+
+	if state == nil {
 		return ErrNoRunningGame
 	}
 
-	p := g.gs.GetPlayer(playerIdx)
+	p := state.GetPlayer(playerIdx)
 	if p == nil {
 		return ErrPlayerNotInGame
 	}
 
-	if !g.gs.HasAction(playerIdx, "ready") || g.rg == nil {
+	if !state.HasAction(playerIdx, "ready") {
 		return ErrInvalidAction
 	}
 
 	//	fmt.Println("RRR", playerIdx)
 
-	g.rg.Ready(int64(playerIdx))
+	g.markReady(playerIdx)
 
 	return nil
 }
@@ -244,11 +276,13 @@ func (g *game) Ready(playerIdx int) error {
 // Shortcut
 func (g *game) ReadyForAll() error {
 
-	if g.gs == nil {
+	state := g.GetState()
+
+	if state == nil {
 		return ErrNoRunningGame
 	}
 
-	gs, err := g.backend.ReadyForAll(g.gs)
+	gs, err := g.backend.ReadyForAll(state)
 	if err != nil {
 		return err
 	}
@@ -260,11 +294,13 @@ func (g *game) ReadyForAll() error {
 
 func (g *game) PayAnte() error {
 
-	if g.gs == nil {
+	state := g.GetState()
+
+	if state == nil {
 		return ErrNoRunningGame
 	}
 
-	gs, err := g.backend.PayAnte(g.gs)
+	gs, err := g.backend.PayAnte(state)
 	if err != nil {
 		return err
 	}
@@ -276,7 +312,9 @@ func (g *game) PayAnte() error {
 
 func (g *game) PayBlinds() error {
 
-	gs, err := g.backend.PayBlinds(g.gs)
+	state := g.GetState()
+
+	gs, err := g.backend.PayBlinds(state)
 	if err != nil {
 		return err
 	}
@@ -288,20 +326,22 @@ func (g *game) PayBlinds() error {
 
 func (g *game) Pass(playerIdx int) error {
 
-	if g.gs == nil {
+	state := g.GetState()
+
+	if state == nil {
 		return ErrNoRunningGame
 	}
 
-	p := g.gs.GetPlayer(playerIdx)
+	p := state.GetPlayer(playerIdx)
 	if p == nil {
 		return ErrPlayerNotInGame
 	}
 
-	if !g.gs.HasAction(playerIdx, "pass") {
+	if !state.HasAction(playerIdx, "pass") {
 		return ErrInvalidAction
 	}
 
-	gs, err := g.backend.Pass(g.gs)
+	gs, err := g.backend.Pass(state)
 	if err != nil {
 		return err
 	}
@@ -313,29 +353,31 @@ func (g *game) Pass(playerIdx int) error {
 
 func (g *game) Pay(playerIdx int, chips int64) error {
 
-	if g.gs == nil {
+	state := g.GetState()
+
+	if state == nil {
 		return ErrNoRunningGame
 	}
 
-	p := g.gs.GetPlayer(playerIdx)
+	p := state.GetPlayer(playerIdx)
 	if p == nil {
 		return ErrPlayerNotInGame
 	}
 
-	if !g.gs.HasAction(playerIdx, "pay") {
+	if !state.HasAction(playerIdx, "pay") {
 		return ErrInvalidAction
 	}
 
 	// For blinds
-	switch g.gs.Status.CurrentEvent {
+	switch state.Status.CurrentEvent {
 	case "AnteRequested":
 		fallthrough
 	case "BlindsRequested":
-		g.rg.Ready(int64(playerIdx))
+		g.markReady(playerIdx)
 		return nil
 	}
 
-	gs, err := g.backend.Pay(g.gs, chips)
+	gs, err := g.backend.Pay(state, chips)
 	if err != nil {
 		return err
 	}
@@ -347,20 +389,22 @@ func (g *game) Pay(playerIdx int, chips int64) error {
 
 func (g *game) Fold(playerIdx int) error {
 
-	if g.gs == nil {
+	state := g.GetState()
+
+	if state == nil {
 		return ErrNoRunningGame
 	}
 
-	p := g.gs.GetPlayer(playerIdx)
+	p := state.GetPlayer(playerIdx)
 	if p == nil {
 		return ErrPlayerNotInGame
 	}
 
-	if !g.gs.HasAction(playerIdx, "fold") {
+	if !state.HasAction(playerIdx, "fold") {
 		return ErrInvalidAction
 	}
 
-	gs, err := g.backend.Fold(g.gs)
+	gs, err := g.backend.Fold(state)
 	if err != nil {
 		return err
 	}
@@ -372,20 +416,22 @@ func (g *game) Fold(playerIdx int) error {
 
 func (g *game) Check(playerIdx int) error {
 
-	if g.gs == nil {
+	state := g.GetState()
+
+	if state == nil {
 		return ErrNoRunningGame
 	}
 
-	p := g.gs.GetPlayer(playerIdx)
+	p := state.GetPlayer(playerIdx)
 	if p == nil {
 		return ErrPlayerNotInGame
 	}
 
-	if !g.gs.HasAction(playerIdx, "check") {
+	if !state.HasAction(playerIdx, "check") {
 		return ErrInvalidAction
 	}
 
-	gs, err := g.backend.Check(g.gs)
+	gs, err := g.backend.Check(state)
 	if err != nil {
 		return err
 	}
@@ -397,20 +443,22 @@ func (g *game) Check(playerIdx int) error {
 
 func (g *game) Call(playerIdx int) error {
 
-	if g.gs == nil {
+	state := g.GetState()
+
+	if state == nil {
 		return ErrNoRunningGame
 	}
 
-	p := g.gs.GetPlayer(playerIdx)
+	p := state.GetPlayer(playerIdx)
 	if p == nil {
 		return ErrPlayerNotInGame
 	}
 
-	if !g.gs.HasAction(playerIdx, "call") {
+	if !state.HasAction(playerIdx, "call") {
 		return ErrInvalidAction
 	}
 
-	gs, err := g.backend.Call(g.gs)
+	gs, err := g.backend.Call(state)
 	if err != nil {
 		return err
 	}
@@ -422,20 +470,22 @@ func (g *game) Call(playerIdx int) error {
 
 func (g *game) Allin(playerIdx int) error {
 
-	if g.gs == nil {
+	state := g.GetState()
+
+	if state == nil {
 		return ErrNoRunningGame
 	}
 
-	p := g.gs.GetPlayer(playerIdx)
+	p := state.GetPlayer(playerIdx)
 	if p == nil {
 		return ErrPlayerNotInGame
 	}
 
-	if !g.gs.HasAction(playerIdx, "allin") {
+	if !state.HasAction(playerIdx, "allin") {
 		return ErrInvalidAction
 	}
 
-	gs, err := g.backend.Allin(g.gs)
+	gs, err := g.backend.Allin(state)
 	if err != nil {
 		return err
 	}
@@ -447,20 +497,22 @@ func (g *game) Allin(playerIdx int) error {
 
 func (g *game) Bet(playerIdx int, chips int64) error {
 
-	if g.gs == nil {
+	state := g.GetState()
+
+	if state == nil {
 		return ErrNoRunningGame
 	}
 
-	p := g.gs.GetPlayer(playerIdx)
+	p := state.GetPlayer(playerIdx)
 	if p == nil {
 		return ErrPlayerNotInGame
 	}
 
-	if !g.gs.HasAction(playerIdx, "bet") {
+	if !state.HasAction(playerIdx, "bet") {
 		return ErrInvalidAction
 	}
 
-	gs, err := g.backend.Bet(g.gs, chips)
+	gs, err := g.backend.Bet(state, chips)
 	if err != nil {
 		return err
 	}
@@ -472,20 +524,22 @@ func (g *game) Bet(playerIdx int, chips int64) error {
 
 func (g *game) Raise(playerIdx int, chipLevel int64) error {
 
-	if g.gs == nil {
+	state := g.GetState()
+
+	if state == nil {
 		return ErrNoRunningGame
 	}
 
-	p := g.gs.GetPlayer(playerIdx)
+	p := state.GetPlayer(playerIdx)
 	if p == nil {
 		return ErrPlayerNotInGame
 	}
 
-	if !g.gs.HasAction(playerIdx, "raise") {
+	if !state.HasAction(playerIdx, "raise") {
 		return ErrInvalidAction
 	}
 
-	gs, err := g.backend.Raise(g.gs, chipLevel)
+	gs, err := g.backend.Raise(state, chipLevel)
 	if err != nil {
 		return err
 	}
@@ -32,11 +32,13 @@ func (t *table) tableLoop() {
 			fmt.Println("TABLE ErrMaxGamesExceeded")
 			t.ts.Status = "closed"
 			t.updateGameState(nil)
+			t.emitTableClosed()
 			return
 		case ErrTimesUp:
 			fmt.Println("TABLE ErrTimesUp")
 			t.ts.Status = "closed"
 			t.updateGameState(nil)
+			t.emitTableClosed()
 			return
 		case ErrInsufficientNumberOfPlayers:
 
@@ -45,6 +47,7 @@ func (t *table) tableLoop() {
 				fmt.Println("TABLE ErrInsufficientNumberOfPlayers")
 				t.ts.Status = "closed"
 				t.updateGameState(nil)
+				t.emitTableClosed()
 				return
 			}
 
@@ -63,6 +66,12 @@ func (t *table) tableLoop() {
 
 		// Continue to the next game
 		t.ts.Status = "pending"
+
+		t.mu.RLock()
+		state := t.cloneState()
+		t.mu.RUnlock()
+
+		t.onInterval(state)
 		t.NewGame(t.options.Interval)
 	}
 
@@ -171,6 +180,21 @@ func (t *table) updatePlayerStates(ts *State) error {
 
 		p.Bankroll = rs.Final
 
+		// Accumulating statistics of player
+		stats, ok := t.stats[p.ID]
+		if !ok {
+			stats = &PlayerStats{}
+			t.stats[p.ID] = stats
+		}
+
+		stats.AddHand(ts.GameState, rs.Idx)
+
+		if t.leavingSeats[p.SeatID] {
+			delete(t.leavingSeats, p.SeatID)
+			t.leave(p.SeatID)
+			continue
+		}
+
 		// Not actively kicking players, waiting for requests to make players leave the table
 		if p.Bankroll == 0 {
 			t.sm.Reserve(p.SeatID)
@@ -190,6 +214,88 @@ func (t *table) emitStateUpdated() {
 	t.onStateUpdated(state)
 }
 
+func (t *table) emitGameEvents(gs *pokerlib.GameState) {
+
+	if gs == nil {
+		return
+	}
+
+	// Emitting actions which are new since last update
+	for ; t.historyCursor < len(gs.Status.ActionHistory); t.historyCursor++ {
+
+		a := gs.Status.ActionHistory[t.historyCursor]
+		if a.Source < 0 {
+			continue
+		}
+
+		p := t.ts.GetPlayerByGameIdx(a.Source)
+		if p == nil {
+			continue
+		}
+
+		t.onEvent(&ActionTakenEvent{
+			TableID:  t.ts.ID,
+			PlayerID: p.ID,
+			Action:   a.Type,
+			Value:    a.Value,
+		})
+	}
+
+	if gs.Status.CurrentEvent == "GameClosed" {
+		t.onEvent(&HandSettledEvent{
+			TableID:    t.ts.ID,
+			GameNumber: t.ts.GamesPlayed,
+			Result:     gs.Result,
+		})
+	}
+}
+
+// actForLeavingPlayers makes players who are leaving go through the rest of game by folding.
+func (t *table) actForLeavingPlayers(gs *pokerlib.GameState) {
+
+	if gs == nil {
+		return
+	}
+
+	for seatID := range t.leavingSeats {
+
+		p, ok := t.ts.Players[seatID]
+		if !ok || p.GameIdx < 0 {
+			continue
+		}
+
+		idx := p.GameIdx
+
+		// Table is locked already, so actions are taken on the game directly
+		switch gs.Status.CurrentEvent {
+		case "ReadyRequested":
+			if gs.HasAction(idx, "ready") {
+				t.g.Ready(idx)
+			}
+		case "AnteRequested", "BlindsRequested":
+			if gs.HasAction(idx, "pay") {
+				t.g.Pay(idx, 0)
+			}
+		case "RoundStarted":
+			if gs.Status.CurrentPlayer != idx {
+				continue
+			}
+
+			if gs.HasAction(idx, "fold") {
+				t.g.Fold(idx)
+			} else if gs.HasAction(idx, "pass") {
+				t.g.Pass(idx)
+			}
+		}
+	}
+}
+
+func (t *table) emitTableClosed() {
+	t.onEvent(&TableClosedEvent{
+		TableID: t.ts.ID,
+	})
+}
+
 func (t *table) cloneState() *State {
 	return t.ts.Clone()
 }
@@ -200,15 +306,19 @@ func (t *table) updateGameState(gs *pokerlib.GameState) error {
 	defer t.mu.Unlock()
 
 	t.ts.GameState = gs
+
+	// Events of the hand go ahead of players who leave after the hand was settled
+	t.emitGameEvents(gs)
 	t.updatePlayerStates(t.ts)
 	t.emitStateUpdated()
+	t.actForLeavingPlayers(gs)
 
 	return nil
 }
 
 func (t *table) checkEndConditions() error {
 
-	if t.options.MaxGames > 0 && t.options.MaxGames == t.gameCount {
+	if t.options.MaxGames > 0 && t.ts.GamesPlayed >= t.options.MaxGames {
 		return ErrMaxGamesExceeded
 	}
 
@@ -227,6 +337,10 @@ func (t *table) prepareNextGame() error {
 	//defer t.mu.Unlock()
 
 	if t.isPaused {
+		t.mu.Lock()
+		t.ts.Status = "pause"
+		t.emitStateUpdated()
+		t.mu.Unlock()
 		return ErrGameCancelled
 	}
 
@@ -244,7 +358,7 @@ func (t *table) prepareNextGame() error {
 
 	// Check the number of player
 	playableCount := t.sm.GetPlayableSeatCount()
-	if t.gameCount == 0 && playableCount < t.options.InitialPlayers {
+	if t.ts.GamesPlayed == 0 && playableCount < t.options.InitialPlayers {
 		return ErrInsufficientNumberOfPlayers
 	} else if playableCount < t.options.MinPlayers {
 		return ErrInsufficientNumberOfPlayers
@@ -277,21 +391,21 @@ func (t *table) startGame() error {
 	// Preparing deck
 	switch t.options.GameType {
 	case "short_deck":
-		opts = pokerlib.NewStardardGameOptions()
+		opts = pokerlib.NewStandardGameOptions()
 		opts.Deck = pokerlib.NewShortDeckCards()
 	default:
-		opts = pokerlib.NewStardardGameOptions()
+		opts = pokerlib.NewStandardGameOptions()
 		opts.Deck = pokerlib.NewStandardDeckCards()
 	}
 
 	// Preparing options
+	t.mu.RLock()
 	opts.Ante = t.options.Ante
 	opts.Blind.Dealer = t.options.Blind.Dealer
 	opts.Blind.SB = t.options.Blind.SB
 	opts.Blind.BB = t.options.Blind.BB
 
 	// Clean legacy status
-	t.mu.RLock()
 	for _, p := range t.ts.Players {
 		p.GameIdx = -1
 	}
@@ -323,13 +437,26 @@ func (t *table) startGame() error {
 		}
 	})
 
+	t.mu.Lock()
+	t.ts.GamesPlayed++
+	t.ts.Status = "playing"
+	t.historyCursor = 0
+	tableID := t.ts.ID
+	gameNumber := t.ts.GamesPlayed
+	t.mu.Unlock()
+
 	err := t.g.Start()
 	if err != nil {
+		t.mu.Lock()
+		t.ts.GamesPlayed--
+		t.mu.Unlock()
 		return err
 	}
 
-	t.gameCount++
-	t.ts.Status = "playing"
+	t.onEvent(&HandStartedEvent{
+		TableID:    tableID,
+		GameNumber: gameNumber,
+	})
 	/*
 		fmt.Println("startGame")
 		for _, p := range t.ts.Players {
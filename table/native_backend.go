@@ -8,12 +8,29 @@ import (
 
 type NativeBackend struct {
 	engine pokerlib.PokerFace
+	store  pokerlib.StateStore
 }
 
-func NewNativeBackend() *NativeBackend {
-	return &NativeBackend{
+type NativeBackendOpt func(*NativeBackend)
+
+// WithStateStore makes backend persist the state after each operation.
+func WithStateStore(store pokerlib.StateStore) NativeBackendOpt {
+	return func(nb *NativeBackend) {
+		nb.store = store
+	}
+}
+
+func NewNativeBackend(opts ...NativeBackendOpt) *NativeBackend {
+
+	nb := &NativeBackend{
 		engine: pokerlib.NewPokerFace(),
 	}
+
+	for _, opt := range opts {
+		opt(nb)
+	}
+
+	return nb
 }
 
 func cloneState(gs *pokerlib.GameState) *pokerlib.GameState {
@@ -33,8 +50,18 @@ func cloneState(gs *pokerlib.GameState) *pokerlib.GameState {
 	return &state
 }
 
-func (nb *NativeBackend) getState(g pokerlib.Game) *pokerlib.GameState {
-	return cloneState(g.GetState())
+func (nb *NativeBackend) getState(g pokerlib.Game) (*pokerlib.GameState, error) {
+
+	gs := cloneState(g.GetState())
+
+	if nb.store != nil {
+		err := nb.store.Save(gs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return gs, nil
 }
 
 func (nb *NativeBackend) CreateGame(opts *pokerlib.GameOptions) (*pokerlib.GameState, error) {
@@ -46,7 +73,7 @@ func (nb *NativeBackend) CreateGame(opts *pokerlib.GameOptions) (*pokerlib.GameS
 		return nil, err
 	}
 
-	return nb.getState(g), nil
+	return nb.getState(g)
 }
 
 func (nb *NativeBackend) Next(gs *pokerlib.GameState) (*pokerlib.GameState, error) {
@@ -57,7 +84,7 @@ func (nb *NativeBackend) Next(gs *pokerlib.GameState) (*pokerlib.GameState, erro
 		return nil, err
 	}
 
-	return nb.getState(g), nil
+	return nb.getState(g)
 }
 
 func (nb *NativeBackend) ReadyForAll(gs *pokerlib.GameState) (*pokerlib.GameState, error) {
@@ -68,7 +95,7 @@ func (nb *NativeBackend) ReadyForAll(gs *pokerlib.GameState) (*pokerlib.GameStat
 		return nil, err
 	}
 
-	return nb.getState(g), nil
+	return nb.getState(g)
 }
 
 func (nb *NativeBackend) Pass(gs *pokerlib.GameState) (*pokerlib.GameState, error) {
@@ -80,7 +107,7 @@ func (nb *NativeBackend) Pass(gs *pokerlib.GameState) (*pokerlib.GameState, erro
 		return nil, err
 	}
 
-	return nb.getState(g), nil
+	return nb.getState(g)
 }
 
 func (nb *NativeBackend) PayAnte(gs *pokerlib.GameState) (*pokerlib.GameState, error) {
@@ -92,7 +119,7 @@ func (nb *NativeBackend) PayAnte(gs *pokerlib.GameState) (*pokerlib.GameState, e
 		return nil, err
 	}
 
-	return nb.getState(g), nil
+	return nb.getState(g)
 }
 
 func (nb *NativeBackend) PayBlinds(gs *pokerlib.GameState) (*pokerlib.GameState, error) {
@@ -104,7 +131,7 @@ func (nb *NativeBackend) PayBlinds(gs *pokerlib.GameState) (*pokerlib.GameState,
 		return nil, err
 	}
 
-	return nb.getState(g), nil
+	return nb.getState(g)
 }
 
 func (nb *NativeBackend) Pay(gs *pokerlib.GameState, chips int64) (*pokerlib.GameState, error) {
@@ -116,7 +143,7 @@ func (nb *NativeBackend) Pay(gs *pokerlib.GameState, chips int64) (*pokerlib.Gam
 		return nil, err
 	}
 
-	return nb.getState(g), nil
+	return nb.getState(g)
 }
 
 func (nb *NativeBackend) Fold(gs *pokerlib.GameState) (*pokerlib.GameState, error) {
@@ -128,7 +155,7 @@ func (nb *NativeBackend) Fold(gs *pokerlib.GameState) (*pokerlib.GameState, erro
 		return nil, err
 	}
 
-	return nb.getState(g), nil
+	return nb.getState(g)
 }
 
 func (nb *NativeBackend) Check(gs *pokerlib.GameState) (*pokerlib.GameState, error) {
@@ -140,7 +167,7 @@ func (nb *NativeBackend) Check(gs *pokerlib.GameState) (*pokerlib.GameState, err
 		return nil, err
 	}
 
-	return nb.getState(g), nil
+	return nb.getState(g)
 }
 
 func (nb *NativeBackend) Call(gs *pokerlib.GameState) (*pokerlib.GameState, error) {
@@ -152,7 +179,7 @@ func (nb *NativeBackend) Call(gs *pokerlib.GameState) (*pokerlib.GameState, erro
 		return nil, err
 	}
 
-	return nb.getState(g), nil
+	return nb.getState(g)
 }
 
 func (nb *NativeBackend) Allin(gs *pokerlib.GameState) (*pokerlib.GameState, error) {
@@ -164,7 +191,7 @@ func (nb *NativeBackend) Allin(gs *pokerlib.GameState) (*pokerlib.GameState, err
 		return nil, err
 	}
 
-	return nb.getState(g), nil
+	return nb.getState(g)
 }
 
 func (nb *NativeBackend) Bet(gs *pokerlib.GameState, chips int64) (*pokerlib.GameState, error) {
@@ -176,7 +203,7 @@ func (nb *NativeBackend) Bet(gs *pokerlib.GameState, chips int64) (*pokerlib.Gam
 		return nil, err
 	}
 
-	return nb.getState(g), nil
+	return nb.getState(g)
 }
 
 func (nb *NativeBackend) Raise(gs *pokerlib.GameState, chipLevel int64) (*pokerlib.GameState, error) {
@@ -188,5 +215,5 @@ func (nb *NativeBackend) Raise(gs *pokerlib.GameState, chipLevel int64) (*pokerl
 		return nil, err
 	}
 
-	return nb.getState(g), nil
+	return nb.getState(g)
 }
@@ -0,0 +1,50 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/d-protocol/pokerlib"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NativeBackend_StateStore(t *testing.T) {
+
+	store := pokerlib.NewMemoryStateStore()
+	backend := NewNativeBackend(WithStateStore(store))
+
+	opts := pokerlib.NewStandardGameOptions()
+	opts.Deck = pokerlib.NewStandardDeckCards()
+	opts.Players = []*pokerlib.PlayerSetting{
+		{Bankroll: 10000, Positions: []string{"dealer"}},
+		{Bankroll: 10000, Positions: []string{"sb"}},
+		{Bankroll: 10000, Positions: []string{"bb"}},
+	}
+
+	gs, err := backend.CreateGame(opts)
+	assert.Nil(t, err)
+
+	gs, err = backend.ReadyForAll(gs)
+	assert.Nil(t, err)
+	gs, err = backend.PayBlinds(gs)
+	assert.Nil(t, err)
+	gs, err = backend.ReadyForAll(gs)
+	assert.Nil(t, err)
+	gs, err = backend.Call(gs)
+	assert.Nil(t, err)
+
+	// State was persisted after the last action
+	stored, err := store.Load(gs.GameID)
+	assert.Nil(t, err)
+	assert.Equal(t, gs.Checksum(), stored.Checksum())
+
+	// Resume the hand from stored state
+	stored, err = backend.Call(stored)
+	assert.Nil(t, err)
+	stored, err = backend.Check(stored)
+	assert.Nil(t, err)
+	assert.Equal(t, "flop", stored.Status.Round)
+
+	latest, err := store.Load(gs.GameID)
+	assert.Nil(t, err)
+	assert.Equal(t, "flop", latest.Status.Round)
+}
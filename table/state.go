@@ -8,14 +8,15 @@ import (
 )
 
 type State struct {
-	ID        string              `json:"id"`
-	GameType  string              `json:"game_type"`
-	StartTime int64               `json:"start_time"`
-	EndTime   int64               `json:"end_time"`
-	Status    string              `json:"status"`
-	Options   *Options            `json:"options"`
-	Players   map[int]*PlayerInfo `json:"player"`
-	GameState *pokerlib.GameState `json:"game_state"`
+	ID          string              `json:"id"`
+	GameType    string              `json:"game_type"`
+	StartTime   int64               `json:"start_time"`
+	EndTime     int64               `json:"end_time"`
+	Status      string              `json:"status"`
+	GamesPlayed int                 `json:"games_played"`
+	Options     *Options            `json:"options"`
+	Players     map[int]*PlayerInfo `json:"player"`
+	GameState   *pokerlib.GameState `json:"game_state"`
 }
 
 func NewState() *State {
@@ -0,0 +1,115 @@
+package table
+
+import (
+	"github.com/d-protocol/pokerlib"
+)
+
+// PlayerStats is accumulating statistics of player across hands.
+type PlayerStats struct {
+	HandsPlayed int `json:"hands_played"`
+	VPIPHands   int `json:"vpip_hands"` // Voluntarily Put In Pot
+	PFRHands    int `json:"pfr_hands"`  // Preflop Raise
+	Aggressions int `json:"aggressions"`
+	Calls       int `json:"calls"`
+}
+
+// VPIP returns the percentage of hands which player voluntarily put chips in pot preflop.
+func (ps *PlayerStats) VPIP() float64 {
+
+	if ps.HandsPlayed == 0 {
+		return 0
+	}
+
+	return float64(ps.VPIPHands) * 100 / float64(ps.HandsPlayed)
+}
+
+// PFR returns the percentage of hands which player raised preflop.
+func (ps *PlayerStats) PFR() float64 {
+
+	if ps.HandsPlayed == 0 {
+		return 0
+	}
+
+	return float64(ps.PFRHands) * 100 / float64(ps.HandsPlayed)
+}
+
+// AggressionFactor returns the ratio of bets and raises to calls.
+func (ps *PlayerStats) AggressionFactor() float64 {
+
+	if ps.Calls == 0 {
+		return float64(ps.Aggressions)
+	}
+
+	return float64(ps.Aggressions) / float64(ps.Calls)
+}
+
+// AddHand accumulates statistics of player with the specific index from action history of a
+// completed game.
+func (ps *PlayerStats) AddHand(gs *pokerlib.GameState, idx int) {
+
+	ps.HandsPlayed++
+
+	preflop := true
+	vpip := false
+	pfr := false
+	maxWager := int64(0)
+	wagers := make(map[int]int64)
+
+	for _, a := range gs.Status.ActionHistory {
+
+		if a.Type == "next" {
+			preflop = false
+			maxWager = 0
+			wagers = make(map[int]int64)
+			continue
+		}
+
+		aggressive := false
+		switch a.Type {
+		case "small_blind", "big_blind", "dealer_blind", "kill_blind", "posted_blind", "bring_in", "call":
+			wagers[a.Source] += a.Value
+		case "bet", "raise", "complete":
+			wagers[a.Source] += a.Value
+			aggressive = true
+		case "allin":
+
+			// Value of all-in is the total wager of this round
+			wagers[a.Source] = a.Value
+			aggressive = a.Value > maxWager
+		default:
+			continue
+		}
+
+		if wagers[a.Source] > maxWager {
+			maxWager = wagers[a.Source]
+		}
+
+		if a.Source != idx {
+			continue
+		}
+
+		switch a.Type {
+		case "small_blind", "big_blind", "dealer_blind", "kill_blind", "posted_blind", "bring_in":
+			continue
+		}
+
+		if aggressive {
+			ps.Aggressions++
+		} else {
+			ps.Calls++
+		}
+
+		if preflop {
+			vpip = true
+			pfr = pfr || aggressive
+		}
+	}
+
+	if vpip {
+		ps.VPIPHands++
+	}
+
+	if pfr {
+		ps.PFRHands++
+	}
+}
@@ -0,0 +1,87 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/d-protocol/pokerlib"
+	"github.com/stretchr/testify/assert"
+)
+
+func newStatsTestGame(t *testing.T) pokerlib.Game {
+
+	opts := pokerlib.NewStandardGameOptions()
+	opts.Deck = pokerlib.NewStandardDeckCards()
+	opts.Players = []*pokerlib.PlayerSetting{
+		{Bankroll: 10000, Positions: []string{"dealer"}},
+		{Bankroll: 10000, Positions: []string{"sb"}},
+		{Bankroll: 10000, Positions: []string{"bb"}},
+	}
+
+	g := pokerlib.NewGame(opts)
+	assert.Nil(t, g.Start())
+	assert.Nil(t, g.ReadyForAll())
+	assert.Nil(t, g.PayBlinds())
+	assert.Nil(t, g.ReadyForAll())
+
+	return g
+}
+
+func Test_PlayerStats(t *testing.T) {
+
+	stats := []*PlayerStats{{}, {}, {}}
+	addHand := func(g pokerlib.Game) {
+		assert.Equal(t, "GameClosed", g.GetEvent())
+		for idx, s := range stats {
+			s.AddHand(g.GetState(), idx)
+		}
+	}
+
+	// Hand 1: dealer raises and big blind calls, then dealer bets on the flop
+	g := newStatsTestGame(t)
+	assert.Nil(t, g.Raise(30))
+	assert.Nil(t, g.Fold())
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.ReadyForAll())
+	assert.Nil(t, g.Pass())
+	assert.Nil(t, g.Check())
+	assert.Nil(t, g.Bet(50))
+	assert.Nil(t, g.Pass())
+	assert.Nil(t, g.Fold())
+	addHand(g)
+
+	// Hand 2: limped pot which is checked down
+	g = newStatsTestGame(t)
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Call())
+	assert.Nil(t, g.Check())
+	for g.GetEvent() != "GameClosed" {
+		assert.Nil(t, g.ReadyForAll())
+		assert.Nil(t, g.Check())
+		assert.Nil(t, g.Check())
+		assert.Nil(t, g.Check())
+	}
+	addHand(g)
+
+	// Hand 3: everyone folds to the big blind
+	g = newStatsTestGame(t)
+	assert.Nil(t, g.Fold())
+	assert.Nil(t, g.Fold())
+	addHand(g)
+
+	// Dealer
+	assert.Equal(t, 3, stats[0].HandsPlayed)
+	assert.Equal(t, 2, stats[0].VPIPHands)
+	assert.Equal(t, 1, stats[0].PFRHands)
+	assert.InDelta(t, 66.67, stats[0].VPIP(), 0.01)
+	assert.InDelta(t, 33.33, stats[0].PFR(), 0.01)
+	assert.Equal(t, float64(2), stats[0].AggressionFactor())
+
+	// Small blind
+	assert.Equal(t, 1, stats[1].VPIPHands)
+	assert.Equal(t, 0, stats[1].PFRHands)
+
+	// Big blind did not put chips in pot voluntarily by checking
+	assert.Equal(t, 1, stats[2].VPIPHands)
+	assert.Equal(t, 0, stats[2].PFRHands)
+	assert.Equal(t, float64(0), stats[2].AggressionFactor())
+}
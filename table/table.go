@@ -6,6 +6,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/d-protocol/pokerlib"
 	"github.com/d-protocol/pokerlib/seat_manager"
 	"github.com/d-protocol/syncsaga"
 	"github.com/d-protocol/timebank"
@@ -23,6 +24,7 @@ var (
 	ErrMaxGamesExceeded            = errors.New("table: reach the maximum number of games")
 	ErrGameCancelled               = errors.New("table: game was cancelled")
 	ErrDisallowSeatReservation     = errors.New("table: disallow seat reservation")
+	ErrPlayerQueued                = errors.New("table: player is queued for available seat")
 )
 
 type TableOpt func(*table)
@@ -35,7 +37,8 @@ type Table interface {
 
 	// Player management
 	Join(seatID int, p *PlayerInfo) (int, error)
-	Leave(seatID int) error
+	WaitingList() []PlayerInfo
+	Leave(seatID int) (int64, error)
 	Reserve(seatID int) error
 	Activate(seatID int) error
 	ActivateByPlayerID(playerID string) error
@@ -48,6 +51,8 @@ type Table interface {
 	GetPlayerByID(playerID string) *PlayerInfo
 	GetPlayerByGameIdx(idx int) *PlayerInfo
 	GetPlayerIdx(playerID string) int
+	GetPlayerStats(playerID string) *PlayerStats
+	CurrentBlinds() (int64, pokerlib.BlindSetting)
 
 	// Setter
 	SetAnte(chips int64)
@@ -56,6 +61,8 @@ type Table interface {
 
 	// Event
 	OnStateUpdated(func(*State))
+	OnEvent(func(TableEvent))
+	OnInterval(func(*State))
 
 	// Actions
 	Ready(playerID string) error
@@ -76,14 +83,19 @@ type table struct {
 	isPaused       bool
 	inPosition     bool
 	options        *Options
-	gameCount      int
 	gameLoop       chan int
 	mu             sync.RWMutex
 	ts             *State
 	rg             *syncsaga.ReadyGroup
 	sm             *seat_manager.SeatManager
 	tb             *timebank.TimeBank
+	historyCursor  int
+	leavingSeats   map[int]bool
+	waitingList    []*PlayerInfo
+	stats          map[string]*PlayerStats
 	onStateUpdated func(*State)
+	onEvent        func(TableEvent)
+	onInterval     func(*State)
 }
 
 func WithBackend(b Backend) TableOpt {
@@ -101,7 +113,12 @@ func NewTable(options *Options, opts ...TableOpt) *table {
 		ts:             NewState(),
 		tb:             timebank.NewTimeBank(),
 		gameLoop:       make(chan int, 1024),
+		leavingSeats:   make(map[int]bool),
+		waitingList:    make([]*PlayerInfo, 0),
+		stats:          make(map[string]*PlayerStats),
 		onStateUpdated: func(*State) {},
+		onEvent:        func(TableEvent) {},
+		onInterval:     func(*State) {},
 	}
 
 	for _, opt := range opts {
@@ -149,6 +166,11 @@ func (t *table) getPlayerIdx(playerID string) int {
 
 func (t *table) leave(seatID int) error {
 
+	p, ok := t.ts.Players[seatID]
+	if !ok {
+		return ErrNotFoundPlayer
+	}
+
 	err := t.sm.Leave(seatID)
 	if err != nil {
 		return err
@@ -156,13 +178,58 @@ func (t *table) leave(seatID int) error {
 
 	delete(t.ts.Players, seatID)
 
+	t.onEvent(&PlayerLeftEvent{
+		TableID:  t.ts.ID,
+		SeatID:   seatID,
+		PlayerID: p.ID,
+		Bankroll: p.Bankroll,
+	})
+
+	t.seatWaitingPlayer()
+
 	return nil
 }
 
+// seatWaitingPlayer seats the first player of waiting list if there is available seat.
+func (t *table) seatWaitingPlayer() {
+
+	if len(t.waitingList) == 0 {
+		return
+	}
+
+	p := t.waitingList[0]
+
+	sid, err := t.sm.Join(-1, p)
+	if err != nil {
+		return
+	}
+
+	t.waitingList = t.waitingList[1:]
+
+	p.SeatID = sid
+	t.ts.Players[sid] = p
+
+	t.onEvent(&PlayerJoinedEvent{
+		TableID:  t.ts.ID,
+		SeatID:   sid,
+		PlayerID: p.ID,
+	})
+}
+
 func (t *table) OnStateUpdated(fn func(*State)) {
 	t.onStateUpdated = fn
 }
 
+func (t *table) OnEvent(fn func(TableEvent)) {
+	t.onEvent = fn
+}
+
+// OnInterval registers a callback which is called with state of table at the beginning of the interval
+// between hands, so that result of the previous hand can be displayed until the next hand is started.
+func (t *table) OnInterval(fn func(*State)) {
+	t.onInterval = fn
+}
+
 func (t *table) GetState() *State {
 	return t.ts
 }
@@ -172,14 +239,53 @@ func (t *table) GetGame() Game {
 }
 
 func (t *table) GetGameCount() int {
-	return t.gameCount
+	return t.ts.GamesPlayed
+}
+
+// GetPlayerStats returns statistics of player accumulated across hands on this table.
+func (t *table) GetPlayerStats(playerID string) *PlayerStats {
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	stats, ok := t.stats[playerID]
+	if !ok {
+		return &PlayerStats{}
+	}
+
+	s := *stats
+
+	return &s
+}
+
+// CurrentBlinds returns ante and blinds applied to the hand in progress. Between hands, it returns those
+// in force for the next hand, which reflects the latest level set to the table.
+func (t *table) CurrentBlinds() (int64, pokerlib.BlindSetting) {
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	gs := t.ts.GameState
+	if gs != nil && gs.Status.CurrentEvent != "GameClosed" {
+		return gs.Meta.Ante, gs.Meta.Blind
+	}
+
+	return t.options.Ante, t.options.Blind
 }
 
 func (t *table) SetAnte(chips int64) {
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
 	t.options.Ante = chips
 }
 
 func (t *table) SetBlinds(dealer int64, sb int64, bb int64) {
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
 	t.options.Blind.Dealer = dealer
 	t.options.Blind.SB = sb
 	t.options.Blind.BB = bb
@@ -213,15 +319,21 @@ func (t *table) NewGame(interval int) error {
 
 func (t *table) Close() error {
 
+	wasRunning := t.isRunning
 	t.isRunning = false
 	t.ts.Status = "closed"
 
 	t.tb.Cancel()
 	close(t.gameLoop)
 
+	if wasRunning {
+		t.emitTableClosed()
+	}
+
 	return nil
 }
 
+// Resume allows new hands to be started again after table was paused.
 func (t *table) Resume() error {
 
 	if !t.isPaused {
@@ -229,6 +341,12 @@ func (t *table) Resume() error {
 	}
 
 	t.isPaused = false
+
+	// Hand which is still in progress is followed by the next hand as usual
+	if t.ts.Status != "pause" {
+		return nil
+	}
+
 	t.ts.Status = "idle"
 
 	if t.isRunning {
@@ -238,6 +356,8 @@ func (t *table) Resume() error {
 	return nil
 }
 
+// Pause prevents new hands from being started until table is resumed, but the current hand is still
+// played to the end. Status becomes "pause" once there is no hand in progress.
 func (t *table) Pause() error {
 
 	if t.isPaused {
@@ -245,7 +365,10 @@ func (t *table) Pause() error {
 	}
 
 	t.isPaused = true
-	t.ts.Status = "pause"
+
+	if t.ts.Status != "playing" {
+		t.ts.Status = "pause"
+	}
 
 	t.tb.Cancel()
 
@@ -296,6 +419,8 @@ func (t *table) ActivateByPlayerID(playerID string) error {
 	return nil
 }
 
+// Join seats player at the specific seat, or any available seat if seatID is -1. Player is added to
+// waiting list and ErrPlayerQueued is returned if table is full.
 func (t *table) Join(seatID int, p *PlayerInfo) (int, error) {
 
 	t.mu.Lock()
@@ -304,6 +429,20 @@ func (t *table) Join(seatID int, p *PlayerInfo) (int, error) {
 	// Game index is -1 by default
 	p.GameIdx = -1
 
+	// Table is full so player should wait for available seat
+	if len(t.ts.Players) >= t.options.MaxSeats {
+
+		t.waitingList = append(t.waitingList, p)
+
+		t.onEvent(&PlayerQueuedEvent{
+			TableID:  t.ts.ID,
+			PlayerID: p.ID,
+			Position: len(t.waitingList),
+		})
+
+		return -1, ErrPlayerQueued
+	}
+
 	sid, err := t.sm.Join(seatID, p)
 	if err != nil {
 		fmt.Println("=====", err, t.ts.ID, p.ID, sid)
@@ -314,23 +453,63 @@ func (t *table) Join(seatID int, p *PlayerInfo) (int, error) {
 	t.ts.Players[sid] = p
 
 	t.emitStateUpdated()
+	t.onEvent(&PlayerJoinedEvent{
+		TableID:  t.ts.ID,
+		SeatID:   sid,
+		PlayerID: p.ID,
+	})
 
 	return sid, nil
 }
 
-func (t *table) Leave(seatID int) error {
+// WaitingList returns players who are waiting for available seat in order.
+func (t *table) WaitingList() []PlayerInfo {
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	players := make([]PlayerInfo, 0, len(t.waitingList))
+	for _, p := range t.waitingList {
+		players = append(players, *p)
+	}
+
+	return players
+}
+
+// Leave makes player leave the table and returns chips the player takes away. Player folds if a hand
+// is in progress and will be removed after the hand was settled, so 0 is returned and chips are
+// reported by PlayerLeftEvent instead.
+func (t *table) Leave(seatID int) (int64, error) {
 
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
+	p, ok := t.ts.Players[seatID]
+	if !ok {
+		return 0, ErrNotFoundPlayer
+	}
+
+	gs := t.ts.GameState
+	if gs != nil && gs.Status.CurrentEvent != "GameClosed" && p.GameIdx >= 0 {
+
+		if gs.GetPlayer(p.GameIdx) != nil {
+
+			// Player will be removed after game closed
+			t.leavingSeats[seatID] = true
+			t.actForLeavingPlayers(gs)
+
+			return 0, nil
+		}
+	}
+
 	err := t.leave(seatID)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	t.emitStateUpdated()
 
-	return nil
+	return p.Bankroll, nil
 }
 
 func (t *table) ResetPositions() {
@@ -375,7 +554,7 @@ func (t *table) Ready(playerID string) error {
 	t.mu.RLock()
 	defer t.mu.RUnlock()
 
-	if !t.isRunning || t.isPaused || t.g == nil {
+	if !t.isRunning || t.g == nil {
 		return nil
 	}
 
@@ -397,7 +576,7 @@ func (t *table) Pass(playerID string) error {
 	t.mu.RLock()
 	defer t.mu.RUnlock()
 
-	if !t.isRunning || t.isPaused || t.g == nil {
+	if !t.isRunning || t.g == nil {
 		return nil
 	}
 
@@ -419,7 +598,7 @@ func (t *table) Pay(playerID string, chips int64) error {
 	t.mu.RLock()
 	defer t.mu.RUnlock()
 
-	if !t.isRunning || t.isPaused || t.g == nil {
+	if !t.isRunning || t.g == nil {
 		return nil
 	}
 
@@ -441,7 +620,7 @@ func (t *table) Fold(playerID string) error {
 	t.mu.RLock()
 	defer t.mu.RUnlock()
 
-	if !t.isRunning || t.isPaused || t.g == nil {
+	if !t.isRunning || t.g == nil {
 		return nil
 	}
 
@@ -463,7 +642,7 @@ func (t *table) Check(playerID string) error {
 	t.mu.RLock()
 	defer t.mu.RUnlock()
 
-	if !t.isRunning || t.isPaused || t.g == nil {
+	if !t.isRunning || t.g == nil {
 		return nil
 	}
 
@@ -485,7 +664,7 @@ func (t *table) Call(playerID string) error {
 	t.mu.RLock()
 	defer t.mu.RUnlock()
 
-	if !t.isRunning || t.isPaused || t.g == nil {
+	if !t.isRunning || t.g == nil {
 		return nil
 	}
 
@@ -507,7 +686,7 @@ func (t *table) Allin(playerID string) error {
 	t.mu.RLock()
 	defer t.mu.RUnlock()
 
-	if !t.isRunning || t.isPaused || t.g == nil {
+	if !t.isRunning || t.g == nil {
 		return nil
 	}
 
@@ -529,7 +708,7 @@ func (t *table) Bet(playerID string, chips int64) error {
 	t.mu.RLock()
 	defer t.mu.RUnlock()
 
-	if !t.isRunning || t.isPaused || t.g == nil {
+	if !t.isRunning || t.g == nil {
 		return nil
 	}
 
@@ -551,7 +730,7 @@ func (t *table) Raise(playerID string, chipLevel int64) error {
 	t.mu.RLock()
 	defer t.mu.RUnlock()
 
-	if !t.isRunning || t.isPaused || t.g == nil {
+	if !t.isRunning || t.g == nil {
 		return nil
 	}
 
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/d-protocol/pokerlib"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -380,3 +381,674 @@ func Test_Table_Join_Pause(t *testing.T) {
 	assert.Equal(t, "closed", table.GetState().Status)
 	assert.Equal(t, opts.MaxGames, table.GetGameCount())
 }
+
+func Test_Table_MaxGames(t *testing.T) {
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	backend := NewNativeBackend()
+	opts := NewOptions()
+	opts.MaxGames = 3
+
+	table := NewTable(opts, WithBackend(backend))
+
+	table.Join(0, &PlayerInfo{
+		ID:       "player_1",
+		Bankroll: 10000,
+	})
+	table.Join(1, &PlayerInfo{
+		ID:       "player_2",
+		Bankroll: 10000,
+	})
+
+	table.Activate(0)
+	table.Activate(1)
+
+	gameCount := 0
+	table.OnStateUpdated(func(ts *State) {
+
+		if ts.Status == "closed" {
+			wg.Done()
+			return
+		}
+
+		if ts.GameState == nil {
+			return
+		}
+
+		if ts.GameState.Status.CurrentEvent == "GameClosed" {
+			gameCount++
+			return
+		}
+
+		// State is updated with lock so players should act asynchronously
+		go playCallingStation(t, table, ts)
+	})
+
+	assert.Nil(t, table.Start())
+
+	wg.Wait()
+
+	assert.Equal(t, "closed", table.GetState().Status)
+	assert.Equal(t, 3, gameCount)
+	assert.Equal(t, 3, table.GetState().GamesPlayed)
+	assert.Equal(t, 3, table.GetGameCount())
+}
+
+func Test_Table_PauseBetweenHands(t *testing.T) {
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	backend := NewNativeBackend()
+	opts := NewOptions()
+	opts.MaxGames = 3
+
+	table := NewTable(opts, WithBackend(backend))
+
+	table.Join(0, &PlayerInfo{
+		ID:       "player_1",
+		Bankroll: 10000,
+	})
+	table.Join(1, &PlayerInfo{
+		ID:       "player_2",
+		Bankroll: 10000,
+	})
+
+	table.Activate(0)
+	table.Activate(1)
+
+	var mu sync.Mutex
+	gameCount := 0
+	paused := make(chan struct{}, 1)
+	table.OnStateUpdated(func(ts *State) {
+
+		switch ts.Status {
+		case "closed":
+			wg.Done()
+			return
+		case "pause":
+			paused <- struct{}{}
+			return
+		}
+
+		if ts.GameState == nil {
+			return
+		}
+
+		if ts.GameState.Status.CurrentEvent == "GameClosed" {
+			mu.Lock()
+			gameCount++
+
+			// Pause at the end of the first hand
+			if gameCount == 1 {
+				assert.Nil(t, table.Pause())
+			}
+			mu.Unlock()
+			return
+		}
+
+		go playCallingStation(t, table, ts)
+	})
+
+	assert.Nil(t, table.Start())
+
+	// No more hand is started after the current hand was finished
+	select {
+	case <-paused:
+	case <-time.After(5 * time.Second):
+		t.Fatal("table was not paused")
+	}
+
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, "pause", table.GetState().Status)
+	assert.Equal(t, 1, table.GetGameCount())
+
+	assert.Nil(t, table.Resume())
+
+	wg.Wait()
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	assert.Equal(t, "closed", table.GetState().Status)
+	assert.Equal(t, 3, gameCount)
+	assert.Equal(t, 3, table.GetGameCount())
+}
+
+func Test_Table_OnInterval(t *testing.T) {
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	backend := NewNativeBackend()
+	opts := NewOptions()
+	opts.MaxGames = 3
+	opts.Interval = 100
+
+	table := NewTable(opts, WithBackend(backend))
+
+	table.Join(0, &PlayerInfo{
+		ID:       "player_1",
+		Bankroll: 10000,
+	})
+	table.Join(1, &PlayerInfo{
+		ID:       "player_2",
+		Bankroll: 10000,
+	})
+
+	table.Activate(0)
+	table.Activate(1)
+
+	var mu sync.Mutex
+	intervals := make([]time.Time, 0)
+	handsStarted := make([]time.Time, 0)
+
+	table.OnInterval(func(ts *State) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		// Result of the previous hand is available
+		assert.Equal(t, "GameClosed", ts.GameState.Status.CurrentEvent)
+		assert.NotNil(t, ts.GameState.Result)
+		assert.Equal(t, len(handsStarted), ts.GamesPlayed)
+
+		intervals = append(intervals, time.Now())
+	})
+
+	table.OnEvent(func(e TableEvent) {
+		switch e.(type) {
+		case *HandStartedEvent:
+			mu.Lock()
+			handsStarted = append(handsStarted, time.Now())
+			mu.Unlock()
+		case *TableClosedEvent:
+			wg.Done()
+		}
+	})
+
+	table.OnStateUpdated(func(ts *State) {
+
+		if ts.GameState == nil || ts.GameState.Status.CurrentEvent == "GameClosed" {
+			return
+		}
+
+		go playCallingStation(t, table, ts)
+	})
+
+	assert.Nil(t, table.Start())
+
+	wg.Wait()
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	// Hook is fired once for each gap between hands, and the next hand waits for the interval
+	assert.Len(t, handsStarted, 3)
+	assert.Len(t, intervals, 2)
+	for i, at := range intervals {
+		assert.GreaterOrEqual(t, handsStarted[i+1].Sub(at), 100*time.Millisecond)
+	}
+}
+
+func Test_Table_CurrentBlinds(t *testing.T) {
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	backend := NewNativeBackend()
+	opts := NewOptions()
+	opts.MaxGames = 3
+
+	table := NewTable(opts, WithBackend(backend))
+
+	ante, blind := table.CurrentBlinds()
+	assert.Equal(t, int64(0), ante)
+	assert.Equal(t, opts.Blind, blind)
+
+	table.Join(0, &PlayerInfo{
+		ID:       "player_1",
+		Bankroll: 10000,
+	})
+	table.Join(1, &PlayerInfo{
+		ID:       "player_2",
+		Bankroll: 10000,
+	})
+
+	table.Activate(0)
+	table.Activate(1)
+
+	// Blinds go up after every hand
+	levels := []pokerlib.BlindSetting{
+		{SB: 5, BB: 10},
+		{SB: 10, BB: 20},
+		{SB: 20, BB: 40},
+	}
+
+	table.SetBlinds(0, levels[0].SB, levels[0].BB)
+
+	var mu sync.Mutex
+	reported := make([]pokerlib.BlindSetting, 0)
+	played := make([]pokerlib.BlindSetting, 0)
+	applied := make(map[int]pokerlib.BlindSetting)
+
+	// Changing blinds during a hand doesn't affect the hand in progress
+	table.OnEvent(func(e TableEvent) {
+		if hs, ok := e.(*HandStartedEvent); ok && hs.GameNumber < opts.MaxGames {
+			table.SetBlinds(0, 1000, 2000)
+		}
+	})
+
+	table.OnInterval(func(ts *State) {
+		next := levels[ts.GamesPlayed]
+		table.SetBlinds(0, next.SB, next.BB)
+
+		_, blind := table.CurrentBlinds()
+
+		mu.Lock()
+		reported = append(reported, blind)
+		mu.Unlock()
+	})
+
+	table.OnStateUpdated(func(ts *State) {
+
+		if ts.Status == "closed" {
+			wg.Done()
+			return
+		}
+
+		if ts.GameState == nil {
+			return
+		}
+
+		if ts.GameState.Status.CurrentEvent == "GameClosed" {
+			mu.Lock()
+			played = append(played, ts.GameState.Meta.Blind)
+			mu.Unlock()
+			return
+		}
+
+		go func() {
+
+			// Hand doesn't move on until current player acts
+			if ts.GameState.Status.CurrentEvent == "RoundStarted" {
+				_, blind := table.CurrentBlinds()
+
+				mu.Lock()
+				applied[ts.GamesPlayed] = blind
+				mu.Unlock()
+			}
+
+			playCallingStation(t, table, ts)
+		}()
+	})
+
+	assert.Nil(t, table.Start())
+
+	wg.Wait()
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	// Reported blinds are in force for the next hand
+	assert.Equal(t, levels[1:], reported)
+	assert.Equal(t, levels, played)
+	for i, level := range levels {
+		assert.Equal(t, level, applied[i+1])
+	}
+
+	_, blind = table.CurrentBlinds()
+	assert.Equal(t, levels[2], blind)
+}
+
+func Test_Table_Events(t *testing.T) {
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	backend := NewNativeBackend()
+	opts := NewOptions()
+	opts.MaxGames = 2
+
+	table := NewTable(opts, WithBackend(backend))
+
+	var mu sync.Mutex
+	events := make(map[string]int)
+	table.OnEvent(func(e TableEvent) {
+
+		mu.Lock()
+		events[e.EventName()]++
+		mu.Unlock()
+
+		if _, ok := e.(*TableClosedEvent); ok {
+			wg.Done()
+		}
+	})
+
+	table.OnStateUpdated(func(ts *State) {
+
+		if ts.GameState == nil || ts.GameState.Status.CurrentEvent == "GameClosed" {
+			return
+		}
+
+		go playCallingStation(t, table, ts)
+	})
+
+	table.Join(0, &PlayerInfo{
+		ID:       "player_1",
+		Bankroll: 10000,
+	})
+	table.Join(1, &PlayerInfo{
+		ID:       "player_2",
+		Bankroll: 10000,
+	})
+
+	table.Activate(0)
+	table.Activate(1)
+
+	assert.Nil(t, table.Start())
+
+	wg.Wait()
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	assert.Equal(t, 2, events["PlayerJoined"])
+	assert.Equal(t, 2, events["HandStarted"])
+	assert.Equal(t, 2, events["HandSettled"])
+	assert.Equal(t, 1, events["TableClosed"])
+	assert.Greater(t, events["ActionTaken"], 0)
+}
+
+// playCallingStation makes players of heads-up table check or call until game is closed.
+func playCallingStation(t *testing.T, table Table, ts *State) {
+
+	switch ts.GameState.Status.CurrentEvent {
+	case "ReadyRequested":
+		for _, p := range ts.Players {
+			assert.Nil(t, table.Ready(p.ID))
+		}
+	case "BlindsRequested":
+		for _, p := range ts.Players {
+			if p.CheckPosition("sb") {
+				assert.Nil(t, table.Pay(p.ID, ts.GameState.Meta.Blind.SB))
+			}
+		}
+		for _, p := range ts.Players {
+			if p.CheckPosition("bb") {
+				assert.Nil(t, table.Pay(p.ID, ts.GameState.Meta.Blind.BB))
+			}
+		}
+	case "RoundStarted":
+		p := ts.GetPlayerByGameIdx(ts.GameState.Status.CurrentPlayer)
+
+		canCheck := false
+		for _, action := range ts.GameState.Players[p.GameIdx].AllowedActions {
+			if action == "check" {
+				canCheck = true
+			}
+		}
+
+		if canCheck {
+			assert.Nil(t, table.Check(p.ID))
+		} else {
+			assert.Nil(t, table.Call(p.ID))
+		}
+	}
+}
+
+func Test_Table_Leave_MidHand(t *testing.T) {
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	backend := NewNativeBackend()
+	opts := NewOptions()
+	opts.MaxGames = 1
+
+	table := NewTable(opts, WithBackend(backend))
+
+	table.Join(0, &PlayerInfo{
+		ID:       "player_1",
+		Bankroll: 10000,
+	})
+	table.Join(1, &PlayerInfo{
+		ID:       "player_2",
+		Bankroll: 10000,
+	})
+	table.Join(2, &PlayerInfo{
+		ID:       "player_3",
+		Bankroll: 10000,
+	})
+
+	table.Activate(0)
+	table.Activate(1)
+	table.Activate(2)
+
+	var mu sync.Mutex
+	leftEvents := make([]*PlayerLeftEvent, 0)
+	table.OnEvent(func(e TableEvent) {
+		if le, ok := e.(*PlayerLeftEvent); ok {
+			mu.Lock()
+			leftEvents = append(leftEvents, le)
+			mu.Unlock()
+		}
+	})
+
+	var result *State
+	left := false
+	table.OnStateUpdated(func(ts *State) {
+
+		if ts.GameState == nil {
+			return
+		}
+
+		if ts.GameState.Status.CurrentEvent == "GameClosed" {
+			result = ts
+			wg.Done()
+			return
+		}
+
+		// Dealer leaves when it is his turn to act
+		if ts.GameState.Status.CurrentEvent == "RoundStarted" && ts.GameState.Status.CurrentPlayer == 0 && !left {
+			left = true
+			go func() {
+
+				// Chips are reported once player was removed
+				chips, err := table.Leave(0)
+				assert.Nil(t, err)
+				assert.Equal(t, int64(0), chips)
+			}()
+			return
+		}
+
+		// Table acts for the player who left
+		if left && ts.GameState.Status.CurrentEvent == "RoundStarted" && ts.GameState.Status.CurrentPlayer == 0 {
+			return
+		}
+
+		go playCallingStation(t, table, ts)
+	})
+
+	assert.Nil(t, table.Start())
+
+	wg.Wait()
+
+	assert.True(t, left)
+	assert.True(t, result.GameState.Players[0].Fold)
+	assert.Len(t, result.GameState.Result.Players, 3)
+
+	// Player should be removed after game closed
+	assert.Nil(t, result.GetPlayerByID("player_1"))
+	assert.Len(t, result.Players, 2)
+
+	// Remaining players have all chips
+	total := int64(0)
+	for _, p := range result.Players {
+		total += p.Bankroll
+	}
+	assert.Equal(t, int64(20000), total)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []*PlayerLeftEvent{
+		{TableID: result.ID, SeatID: 0, PlayerID: "player_1", Bankroll: 10000},
+	}, leftEvents)
+}
+
+func Test_Table_Leave_Blinds(t *testing.T) {
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	backend := NewNativeBackend()
+	opts := NewOptions()
+	opts.MaxGames = 1
+
+	table := NewTable(opts, WithBackend(backend))
+	table.SetBlinds(0, 5, 10)
+
+	table.Join(0, &PlayerInfo{
+		ID:       "player_1",
+		Bankroll: 10000,
+	})
+	table.Join(1, &PlayerInfo{
+		ID:       "player_2",
+		Bankroll: 10000,
+	})
+	table.Join(2, &PlayerInfo{
+		ID:       "player_3",
+		Bankroll: 10000,
+	})
+
+	table.Activate(0)
+	table.Activate(1)
+	table.Activate(2)
+
+	var mu sync.Mutex
+	leftEvents := make([]*PlayerLeftEvent, 0)
+	table.OnEvent(func(e TableEvent) {
+		if le, ok := e.(*PlayerLeftEvent); ok {
+			mu.Lock()
+			leftEvents = append(leftEvents, le)
+			mu.Unlock()
+		}
+	})
+
+	var sb *PlayerInfo
+	table.OnStateUpdated(func(ts *State) {
+
+		if ts.GameState == nil {
+			return
+		}
+
+		if ts.GameState.Status.CurrentEvent == "GameClosed" {
+			wg.Done()
+			return
+		}
+
+		// Small blind leaves before blinds were paid
+		if ts.GameState.Status.CurrentEvent == "BlindsRequested" && sb == nil {
+			for _, p := range ts.Players {
+				if p.CheckPosition("sb") {
+					sb = p
+				}
+			}
+
+			go func() {
+				chips, err := table.Leave(sb.SeatID)
+				assert.Nil(t, err)
+				assert.Equal(t, int64(0), chips)
+
+				playCallingStation(t, table, ts)
+			}()
+			return
+		}
+
+		// Table acts for the player who left
+		if sb != nil && ts.GameState.Status.CurrentEvent == "RoundStarted" && ts.GameState.Status.CurrentPlayer == sb.GameIdx {
+			return
+		}
+
+		go playCallingStation(t, table, ts)
+	})
+
+	assert.Nil(t, table.Start())
+
+	wg.Wait()
+
+	// Small blind was paid before player folded
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []*PlayerLeftEvent{
+		{TableID: table.GetState().ID, SeatID: sb.SeatID, PlayerID: sb.ID, Bankroll: 9995},
+	}, leftEvents)
+}
+
+func Test_Table_Leave_Idle(t *testing.T) {
+
+	table := NewTable(NewOptions(), WithBackend(NewNativeBackend()))
+
+	table.Join(0, &PlayerInfo{
+		ID:       "player_1",
+		Bankroll: 10000,
+	})
+
+	chips, err := table.Leave(0)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10000), chips)
+	assert.Nil(t, table.GetState().GetPlayerByID("player_1"))
+
+	_, err = table.Leave(0)
+	assert.Equal(t, ErrNotFoundPlayer, err)
+}
+
+func Test_Table_WaitingList(t *testing.T) {
+
+	opts := NewOptions()
+	opts.MaxSeats = 2
+
+	table := NewTable(opts, WithBackend(NewNativeBackend()))
+
+	events := make([]TableEvent, 0)
+	table.OnEvent(func(e TableEvent) {
+		events = append(events, e)
+	})
+
+	sid, err := table.Join(0, &PlayerInfo{ID: "player_1", Bankroll: 10000})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, sid)
+
+	sid, err = table.Join(1, &PlayerInfo{ID: "player_2", Bankroll: 10000})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, sid)
+
+	// Table is full
+	sid, err = table.Join(-1, &PlayerInfo{ID: "player_3", Bankroll: 10000})
+	assert.ErrorIs(t, err, ErrPlayerQueued)
+	assert.Equal(t, -1, sid)
+	assert.Nil(t, table.GetState().GetPlayerByID("player_3"))
+
+	waitingList := table.WaitingList()
+	assert.Len(t, waitingList, 1)
+	assert.Equal(t, "player_3", waitingList[0].ID)
+
+	// Seat is available for waiting player
+	_, err = table.Leave(0)
+	assert.Nil(t, err)
+
+	assert.Empty(t, table.WaitingList())
+
+	p := table.GetState().GetPlayerByID("player_3")
+	assert.NotNil(t, p)
+	assert.Equal(t, 0, p.SeatID)
+
+	assert.Equal(t, []TableEvent{
+		&PlayerJoinedEvent{TableID: table.GetState().ID, SeatID: 0, PlayerID: "player_1"},
+		&PlayerJoinedEvent{TableID: table.GetState().ID, SeatID: 1, PlayerID: "player_2"},
+		&PlayerQueuedEvent{TableID: table.GetState().ID, PlayerID: "player_3", Position: 1},
+		&PlayerLeftEvent{TableID: table.GetState().ID, SeatID: 0, PlayerID: "player_1", Bankroll: 10000},
+		&PlayerJoinedEvent{TableID: table.GetState().ID, SeatID: 0, PlayerID: "player_3"},
+	}, events)
+}
@@ -12,7 +12,7 @@ func Test_Actions_Basic(t *testing.T) {
 	pf := pokerlib.NewPokerFace()
 
 	// Options
-	opts := pokerlib.NewStardardGameOptions()
+	opts := pokerlib.NewStandardGameOptions()
 	opts.Ante = 10
 
 	// Preparing deck
@@ -153,7 +153,7 @@ func Test_Actions_TwoPlayers(t *testing.T) {
 	pf := pokerlib.NewPokerFace()
 
 	// Options
-	opts := pokerlib.NewStardardGameOptions()
+	opts := pokerlib.NewStandardGameOptions()
 	opts.Ante = 10
 
 	// Preparing deck
@@ -226,7 +226,7 @@ func Test_Fold_RaiseInPreflop(t *testing.T) {
 	pf := pokerlib.NewPokerFace()
 
 	// Options
-	opts := pokerlib.NewStardardGameOptions()
+	opts := pokerlib.NewStandardGameOptions()
 	opts.Ante = 10
 
 	// Preparing deck
@@ -318,7 +318,7 @@ func Test_Actions_CallTo1BBInPreflop(t *testing.T) {
 	pf := pokerlib.NewPokerFace()
 
 	// Options
-	opts := pokerlib.NewStardardGameOptions()
+	opts := pokerlib.NewStandardGameOptions()
 	opts.Blind.SB = 10
 	opts.Blind.BB = 20
 	opts.Ante = 0
@@ -379,7 +379,7 @@ func Test_Actions_EmptySB_Basic(t *testing.T) {
 	pf := pokerlib.NewPokerFace()
 
 	// Options
-	opts := pokerlib.NewStardardGameOptions()
+	opts := pokerlib.NewStandardGameOptions()
 	opts.Blind.SB = 10
 	opts.Blind.BB = 20
 	opts.Ante = 0
@@ -500,7 +500,7 @@ func Test_Actions_EmptySB_PreflopAllin(t *testing.T) {
 	pf := pokerlib.NewPokerFace()
 
 	// Options
-	opts := pokerlib.NewStardardGameOptions()
+	opts := pokerlib.NewStandardGameOptions()
 	opts.Blind.SB = 10
 	opts.Blind.BB = 20
 	opts.Ante = 0
@@ -579,7 +579,7 @@ func Test_Actions_EmptySB_PreflopAllin2(t *testing.T) {
 	pf := pokerlib.NewPokerFace()
 
 	// Options
-	opts := pokerlib.NewStardardGameOptions()
+	opts := pokerlib.NewStandardGameOptions()
 	opts.Blind.SB = 10
 	opts.Blind.BB = 20
 	opts.Ante = 0
@@ -658,7 +658,7 @@ func Test_Actions_EmptySB_PreflopAllin2(t *testing.T) {
 // 	pf := pokerlib.NewPokerFace()
 
 // 	// Options
-// 	opts := pokerlib.NewStardardGameOptions()
+// 	opts := pokerlib.NewStandardGameOptions()
 // 	opts.Blind.SB = 10
 // 	opts.Blind.BB = 20
 // 	opts.Ante = 1
@@ -702,7 +702,7 @@ func Test_Actions_CurrentRaiser_AllinButNotRaiser(t *testing.T) {
 	pf := pokerlib.NewPokerFace()
 
 	// Options
-	opts := pokerlib.NewStardardGameOptions()
+	opts := pokerlib.NewStandardGameOptions()
 	opts.Blind.SB = 10
 	opts.Blind.BB = 20
 	opts.Ante = 0
@@ -769,7 +769,7 @@ func Test_Actions_CurrentRaiser_AllinButNotRaiser2(t *testing.T) {
 	pf := pokerlib.NewPokerFace()
 
 	// Options
-	opts := pokerlib.NewStardardGameOptions()
+	opts := pokerlib.NewStandardGameOptions()
 	opts.Blind.SB = 10
 	opts.Blind.BB = 20
 	opts.Ante = 0
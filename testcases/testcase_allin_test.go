@@ -11,7 +11,7 @@ func Test_Allin_Basic(t *testing.T) {
 
 	pf := pokerlib.NewPokerFace()
 
-	opts := pokerlib.NewStardardGameOptions()
+	opts := pokerlib.NewStandardGameOptions()
 	opts.Ante = 10
 
 	// Preparing deck
@@ -152,7 +152,7 @@ func Test_Allin_NoOneCanMove(t *testing.T) {
 
 	pf := pokerlib.NewPokerFace()
 
-	opts := pokerlib.NewStardardGameOptions()
+	opts := pokerlib.NewStandardGameOptions()
 	opts.Ante = 10
 
 	// Preparing deck
@@ -261,7 +261,7 @@ func Test_Allin_PreviousRaiseSize(t *testing.T) {
 
 	pf := pokerlib.NewPokerFace()
 
-	opts := pokerlib.NewStardardGameOptions()
+	opts := pokerlib.NewStandardGameOptions()
 	opts.Blind.SB = 50
 	opts.Blind.BB = 100
 	opts.Ante = 0
@@ -339,7 +339,7 @@ func Test_Allin_PreflopFirstCurrentPlayer(t *testing.T) {
 
 	pf := pokerlib.NewPokerFace()
 
-	opts := pokerlib.NewStardardGameOptions()
+	opts := pokerlib.NewStandardGameOptions()
 	opts.Blind.SB = 150
 	opts.Blind.BB = 300
 	opts.Ante = 30
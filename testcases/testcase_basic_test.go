@@ -18,7 +18,7 @@ func Test_Basic(t *testing.T) {
 
 	pf := pokerlib.NewPokerFace()
 
-	opts := pokerlib.NewStardardGameOptions()
+	opts := pokerlib.NewStandardGameOptions()
 	opts.Ante = 10
 
 	// Preparing deck
@@ -322,7 +322,7 @@ func Test_Basic_NinePlayers(t *testing.T) {
 	pf := pokerlib.NewPokerFace()
 
 	// Options
-	opts := pokerlib.NewStardardGameOptions()
+	opts := pokerlib.NewStandardGameOptions()
 	opts.Ante = 10
 
 	// Preparing deck
@@ -11,7 +11,7 @@ func Test_Fold(t *testing.T) {
 
 	pf := pokerlib.NewPokerFace()
 
-	opts := pokerlib.NewStardardGameOptions()
+	opts := pokerlib.NewStandardGameOptions()
 	opts.Ante = 10
 
 	// Preparing deck
@@ -141,7 +141,7 @@ func Test_Fold_PassRequired(t *testing.T) {
 	pf := pokerlib.NewPokerFace()
 
 	// Options
-	opts := pokerlib.NewStardardGameOptions()
+	opts := pokerlib.NewStandardGameOptions()
 	opts.Ante = 10
 
 	// Preparing deck
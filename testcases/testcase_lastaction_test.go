@@ -12,7 +12,7 @@ func Test_LastAction(t *testing.T) {
 	pf := pokerlib.NewPokerFace()
 
 	// Options
-	opts := pokerlib.NewStardardGameOptions()
+	opts := pokerlib.NewStandardGameOptions()
 	opts.Ante = 10
 
 	// Preparing deck
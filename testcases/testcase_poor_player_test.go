@@ -12,7 +12,7 @@ func Test_PoorPlayer_DealerEnoughForAnteOnly(t *testing.T) {
 	pf := pokerlib.NewPokerFace()
 
 	// Options
-	opts := pokerlib.NewStardardGameOptions()
+	opts := pokerlib.NewStandardGameOptions()
 	opts.Ante = 10
 
 	// Preparing deck
@@ -103,7 +103,7 @@ func Test_PoorPlayer_DealerEnoughForAnteAndBlindOnly(t *testing.T) {
 	pf := pokerlib.NewPokerFace()
 
 	// Options
-	opts := pokerlib.NewStardardGameOptions()
+	opts := pokerlib.NewStandardGameOptions()
 	opts.Ante = 10
 
 	// Preparing deck
@@ -12,7 +12,7 @@ func Test_Pot_Basic(t *testing.T) {
 	pf := pokerlib.NewPokerFace()
 
 	// Options
-	opts := pokerlib.NewStardardGameOptions()
+	opts := pokerlib.NewStandardGameOptions()
 	opts.Blind.SB = 100
 	opts.Blind.BB = 200
 
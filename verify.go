@@ -0,0 +1,114 @@
+package pokerlib
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrDealMismatch = errors.New("game: dealt cards do not match deck")
+)
+
+// VerifyDeals recomputes cards which were dealt to players and board from deck of state by dealing
+// rules, and compares them to the recorded hole cards, board and burned cards, which is used to
+// confirm the deals of a stored hand. One card is burned before each street as the engine does.
+// Hole cards must not be hidden from state.
+func VerifyDeals(gs *GameState) error {
+
+	if len(gs.Meta.Deck) == 0 {
+		return ErrNoDeck
+	}
+
+	g := NewGameFromState(gs.Clone())
+	deck := gs.Meta.Deck
+
+	// Hole cards
+	pos := 0
+	holeCards := make(map[int][]string)
+	if len(gs.Status.Round) > 0 {
+		for _, idx := range g.holeCardsDealingOrder() {
+			if pos >= len(deck) {
+				return ErrInsufficientCards
+			}
+
+			holeCards[idx] = append(holeCards[idx], deck[pos])
+			pos++
+		}
+	}
+
+	for _, ps := range gs.Players {
+		if !equalCards(ps.HoleCards, holeCards[ps.Idx]) {
+			return fmt.Errorf("%w: hole cards of seat %d are %v, expected %v", ErrDealMismatch, ps.Idx, ps.HoleCards, holeCards[ps.Idx])
+		}
+	}
+
+	// Board and burned cards of streets which were dealt
+	board := make([]string, 0, len(gs.Status.Board))
+	burned := make([]string, 0, len(gs.Status.Burned))
+	for _, count := range g.boardLayout()[:g.dealtStreetCount()] {
+		if pos+1+count > len(deck) {
+			return ErrInsufficientCards
+		}
+
+		burned = append(burned, deck[pos])
+		board = append(board, deck[pos+1:pos+1+count]...)
+		pos += 1 + count
+	}
+
+	if !equalCards(gs.Status.Board, board) {
+		return fmt.Errorf("%w: board is %v, expected %v", ErrDealMismatch, gs.Status.Board, board)
+	}
+
+	// Additional runs share the board before runout and are dealt after the first run
+	if len(gs.Status.Runs) > 1 {
+
+		layout := g.boardLayout()
+		shared := 0
+		for _, count := range layout[:gs.Status.RunoutStreet] {
+			shared += count
+		}
+
+		for run, runBoard := range gs.Status.Runs[1:] {
+
+			expected := append([]string{}, board[:shared]...)
+			for _, count := range layout[gs.Status.RunoutStreet:] {
+				if pos+1+count > len(deck) {
+					return ErrInsufficientCards
+				}
+
+				burned = append(burned, deck[pos])
+				expected = append(expected, deck[pos+1:pos+1+count]...)
+				pos += 1 + count
+			}
+
+			if !equalCards(runBoard, expected) {
+				return fmt.Errorf("%w: board of run %d is %v, expected %v", ErrDealMismatch, run+2, runBoard, expected)
+			}
+		}
+	}
+
+	if !equalCards(gs.Status.Burned, burned) {
+		return fmt.Errorf("%w: burned cards are %v, expected %v", ErrDealMismatch, gs.Status.Burned, burned)
+	}
+
+	if pos != gs.Status.CurrentDeckPosition {
+		return fmt.Errorf("%w: deck position is %d, expected %d", ErrDealMismatch, gs.Status.CurrentDeckPosition, pos)
+	}
+
+	return nil
+}
+
+func equalCards(a []string, b []string) bool {
+
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i, c := range a {
+		if c != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
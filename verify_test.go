@@ -0,0 +1,83 @@
+package pokerlib
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func playToShowdown(t *testing.T, g *game) {
+
+	for !g.IsHandComplete() {
+		switch {
+		case g.GetEvent() == "ReadyRequested":
+			assert.Nil(t, g.ReadyForAll())
+		case g.GetCurrentPlayer().CheckAction("call"):
+			assert.Nil(t, g.Call())
+		case g.GetCurrentPlayer().CheckAction("check"):
+			assert.Nil(t, g.Check())
+		default:
+			assert.Nil(t, g.Pass())
+		}
+	}
+}
+
+func TestVerifyDeals(t *testing.T) {
+
+	for _, oneAtATime := range []bool{false, true} {
+		opts := newTestGameOptions(10000, 10000, 10000, 10000)
+		opts.DealOneAtATime = oneAtATime
+
+		g := startTestGame(t, opts)
+		playToShowdown(t, g)
+
+		gs := g.GetState()
+		assert.Len(t, gs.Status.Board, 5)
+		assert.Nil(t, VerifyDeals(gs))
+
+		// Stored state
+		assert.Nil(t, VerifyDeals(gs.Clone()))
+	}
+
+	// Hand which is closed before flop
+	g := startTestGame(t, newTestGameOptions(10000, 10000, 10000))
+	assert.Nil(t, g.Fold())
+	assert.Nil(t, g.Fold())
+	assert.Empty(t, g.GetState().Status.Board)
+	assert.Nil(t, VerifyDeals(g.GetState()))
+}
+
+func TestVerifyDeals_Mismatch(t *testing.T) {
+
+	g := startTestGame(t, newTestGameOptions(10000, 10000, 10000))
+	playToShowdown(t, g)
+
+	tamper := func(fn func(gs *GameState)) error {
+		gs := g.GetState().Clone()
+		fn(gs)
+		return VerifyDeals(gs)
+	}
+
+	err := tamper(func(gs *GameState) {
+		gs.Players[0].HoleCards, gs.Players[1].HoleCards = gs.Players[1].HoleCards, gs.Players[0].HoleCards
+	})
+	assert.True(t, errors.Is(err, ErrDealMismatch))
+	assert.Contains(t, err.Error(), "seat 0")
+
+	err = tamper(func(gs *GameState) {
+		gs.Status.Board[4], gs.Status.Burned[2] = gs.Status.Burned[2], gs.Status.Board[4]
+	})
+	assert.True(t, errors.Is(err, ErrDealMismatch))
+	assert.Contains(t, err.Error(), "board")
+
+	err = tamper(func(gs *GameState) {
+		gs.Status.Burned = gs.Status.Burned[:2]
+	})
+	assert.True(t, errors.Is(err, ErrDealMismatch))
+	assert.Contains(t, err.Error(), "burned")
+
+	assert.Equal(t, ErrNoDeck, tamper(func(gs *GameState) {
+		gs.Meta.Deck = nil
+	}))
+}